@@ -5,17 +5,18 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/benchplus/goorm/internal/models"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/benchplus/goorm/internal/sqldriver"
 )
 
 type BormORM struct {
-	db          *sql.DB
-	insertStmt  *sql.Stmt
-	updateStmt  *sql.Stmt
-	deleteStmt  *sql.Stmt
-	countStmt   *sql.Stmt
+	db         *sql.DB
+	insertStmt *sql.Stmt
+	updateStmt *sql.Stmt
+	deleteStmt *sql.Stmt
+	countStmt  *sql.Stmt
 }
 
 func New() *BormORM {
@@ -24,7 +25,7 @@ func New() *BormORM {
 
 func (bo *BormORM) Init(dsn string) error {
 	var err error
-	bo.db, err = sql.Open("sqlite3", dsn)
+	bo.db, err = sql.Open(sqldriver.Name(), dsn)
 	if err != nil {
 		return err
 	}
@@ -32,17 +33,18 @@ func (bo *BormORM) Init(dsn string) error {
 	return nil
 }
 
-// prepareStatements 预编译常用语句，在CreateTable之后调用
+// prepareStatements 预编译常用语句，在CreateTable之后调用；已预编译的语句直接复用。
+// 语句在并发使用之前准备好，之后只读，各方法直接使用
 func (bo *BormORM) prepareStatements() error {
 	var err error
 	if bo.insertStmt == nil {
-		bo.insertStmt, err = bo.db.Prepare(`INSERT INTO users (name, email, age) VALUES (?, ?, ?)`)
+		bo.insertStmt, err = bo.db.Prepare(`INSERT INTO users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`)
 		if err != nil {
 			return err
 		}
 	}
 	if bo.updateStmt == nil {
-		bo.updateStmt, err = bo.db.Prepare(`UPDATE users SET name = ?, email = ?, age = ? WHERE id = ?`)
+		bo.updateStmt, err = bo.db.Prepare(`UPDATE users SET name = ?, email = ?, age = ?, created_at = ?, updated_at = ? WHERE id = ?`)
 		if err != nil {
 			return err
 		}
@@ -85,26 +87,25 @@ func (bo *BormORM) CreateTable() error {
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			name VARCHAR(100) NOT NULL,
 			email VARCHAR(100) NOT NULL,
-			age INTEGER NOT NULL
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
 		)
 	`)
 	if err != nil {
 		return err
 	}
 
-	// 创建 posts 表
-	_, err = bo.db.Exec(`
-		CREATE TABLE IF NOT EXISTS posts (
-			id INTEGER PRIMARY KEY AUTOINCREMENT,
-			user_id INTEGER NOT NULL,
-			title VARCHAR(200) NOT NULL,
-			body TEXT NOT NULL
-		)
-	`)
+	_, err = bo.db.Exec(`CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at)`)
 	if err != nil {
 		return err
 	}
-	
+
+	// 创建 posts 表
+	if err := bo.CreatePostTable(); err != nil {
+		return err
+	}
+
 	// 表创建后预编译语句
 	return bo.prepareStatements()
 }
@@ -114,18 +115,12 @@ func (bo *BormORM) DropTable() error {
 	if err != nil {
 		return err
 	}
-	_, err = bo.db.Exec("DROP TABLE IF EXISTS posts")
-	return err
+	return bo.DropPostTable()
 }
 
 func (bo *BormORM) Insert(user *models.User) error {
 	// 使用预编译语句，提升性能
-	if bo.insertStmt == nil {
-		if err := bo.prepareStatements(); err != nil {
-			return err
-		}
-	}
-	result, err := bo.insertStmt.Exec(user.Name, user.Email, user.Age)
+	result, err := bo.insertStmt.Exec(user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
 	if err != nil {
 		return err
 	}
@@ -144,13 +139,13 @@ func (bo *BormORM) InsertBatch(users []*models.User) error {
 
 	// 使用多行INSERT语句，一次性插入所有记录，性能最优
 	// 构建 VALUES 子句
-	query := `INSERT INTO users (name, email, age) VALUES `
-	args := make([]interface{}, 0, len(users)*3)
+	query := `INSERT INTO users (name, email, age, created_at, updated_at) VALUES `
+	args := make([]interface{}, 0, len(users)*5)
 	placeholders := make([]string, 0, len(users))
-	
+
 	for _, user := range users {
-		placeholders = append(placeholders, "(?, ?, ?)")
-		args = append(args, user.Name, user.Email, user.Age)
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
+		args = append(args, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
 	}
 	query += strings.Join(placeholders, ", ")
 
@@ -160,13 +155,14 @@ func (bo *BormORM) InsertBatch(users []*models.User) error {
 		return err
 	}
 
-	// 获取第一个插入的ID，然后依次递增（SQLite的last_insert_rowid返回第一个ID）
-	firstID, err := result.LastInsertId()
+	// 多行插入时 last_insert_rowid 为最后一行的 ID，单语句内自增 ID 连续
+	lastID, err := result.LastInsertId()
 	if err != nil {
 		return err
 	}
 
-	// 为所有用户设置ID（SQLite批量插入时，ID是连续的）
+	// 为所有用户设置ID
+	firstID := lastID - int64(len(users)) + 1
 	for i := range users {
 		users[i].ID = firstID + int64(i)
 	}
@@ -177,8 +173,8 @@ func (bo *BormORM) InsertBatch(users []*models.User) error {
 func (bo *BormORM) GetByID(id int64) (*models.User, error) {
 	// 使用原生SQL替代borm抽象，提升性能
 	user := &models.User{}
-	err := bo.db.QueryRow("SELECT id, name, email, age FROM users WHERE id = ?", id).
-		Scan(&user.ID, &user.Name, &user.Email, &user.Age)
+	err := bo.db.QueryRow("SELECT id, name, email, age, created_at, updated_at FROM users WHERE id = ?", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("user not found")
 	}
@@ -201,7 +197,7 @@ func (bo *BormORM) GetByIDs(ids []int64) ([]*models.User, error) {
 		placeholders[i] = "?"
 		args[i] = id
 	}
-	query := "SELECT id, name, email, age FROM users WHERE id IN (" + strings.Join(placeholders, ", ") + ")"
+	query := "SELECT id, name, email, age, created_at, updated_at FROM users WHERE id IN (" + strings.Join(placeholders, ", ") + ")"
 
 	rows, err := bo.db.Query(query, args...)
 	if err != nil {
@@ -212,7 +208,7 @@ func (bo *BormORM) GetByIDs(ids []int64) ([]*models.User, error) {
 	users := make([]*models.User, 0, len(ids))
 	for rows.Next() {
 		var user models.User
-		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
 			return nil, err
 		}
 		users = append(users, &user)
@@ -222,33 +218,18 @@ func (bo *BormORM) GetByIDs(ids []int64) ([]*models.User, error) {
 
 func (bo *BormORM) Update(user *models.User) error {
 	// 使用预编译语句，提升性能
-	if bo.updateStmt == nil {
-		if err := bo.prepareStatements(); err != nil {
-			return err
-		}
-	}
-	_, err := bo.updateStmt.Exec(user.Name, user.Email, user.Age, user.ID)
+	_, err := bo.updateStmt.Exec(user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC(), user.ID)
 	return err
 }
 
 func (bo *BormORM) Delete(id int64) error {
 	// 使用预编译语句，提升性能
-	if bo.deleteStmt == nil {
-		if err := bo.prepareStatements(); err != nil {
-			return err
-		}
-	}
 	_, err := bo.deleteStmt.Exec(id)
 	return err
 }
 
 func (bo *BormORM) Count() (int64, error) {
 	// 使用预编译语句，提升性能
-	if bo.countStmt == nil {
-		if err := bo.prepareStatements(); err != nil {
-			return 0, err
-		}
-	}
 	var count int64
 	err := bo.countStmt.QueryRow().Scan(&count)
 	return count, err
@@ -257,7 +238,7 @@ func (bo *BormORM) Count() (int64, error) {
 func (bo *BormORM) GetAll(limit, offset int) ([]*models.User, error) {
 	var users []*models.User
 	// 使用原生SQL查询替代borm的Select，提升性能
-	rows, err := bo.db.Query("SELECT id, name, email, age FROM users LIMIT ? OFFSET ?", limit, offset)
+	rows, err := bo.db.Query("SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -265,7 +246,7 @@ func (bo *BormORM) GetAll(limit, offset int) ([]*models.User, error) {
 
 	for rows.Next() {
 		var user models.User
-		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
 			return nil, err
 		}
 		users = append(users, &user)
@@ -273,6 +254,61 @@ func (bo *BormORM) GetAll(limit, offset int) ([]*models.User, error) {
 	return users, rows.Err()
 }
 
+func (bo *BormORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	rows, err := bo.db.Query("SELECT id, name, email, age, created_at, updated_at FROM users WHERE created_at >= ? AND created_at < ? ORDER BY created_at", from.UTC(), to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (bo *BormORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	rows, err := bo.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (bo *BormORM) Iterate(fn func(*models.User) error) error {
+	rows, err := bo.db.Query("SELECT id, name, email, age, created_at, updated_at FROM users")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return err
+		}
+		if err := fn(&user); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 // GetDSN 生成测试用的 DSN
 func GetDSN() string {
 	// 使用与GORM相同的DSN格式，启用缓存和内存模式
@@ -0,0 +1,70 @@
+package borm
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (bo *BormORM) CreateJSONTable() error {
+	_, err := bo.db.Exec(`
+		CREATE TABLE IF NOT EXISTS profiles (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			settings TEXT NOT NULL,
+			attrs TEXT NOT NULL
+		)
+	`)
+	return err
+}
+
+func (bo *BormORM) DropJSONTable() error {
+	_, err := bo.db.Exec("DROP TABLE IF EXISTS profiles")
+	return err
+}
+
+func (bo *BormORM) InsertProfile(profile *models.Profile) error {
+	result, err := bo.db.Exec(`INSERT INTO profiles (name, settings, attrs) VALUES (?, ?, ?)`,
+		profile.Name, models.JSON{V: &profile.Settings}, models.JSON{V: &profile.Attrs})
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	profile.ID = id
+	return nil
+}
+
+func (bo *BormORM) GetProfileByID(id int64) (*models.Profile, error) {
+	profile := &models.Profile{}
+	err := bo.db.QueryRow("SELECT id, name, settings, attrs FROM profiles WHERE id = ?", id).
+		Scan(&profile.ID, &profile.Name, models.JSON{V: &profile.Settings}, models.JSON{V: &profile.Attrs})
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("profile not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (bo *BormORM) GetProfilesByTheme(theme string) ([]*models.Profile, error) {
+	rows, err := bo.db.Query("SELECT id, name, settings, attrs FROM profiles WHERE json_extract(settings, '$.theme') = ? ORDER BY id", theme)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*models.Profile
+	for rows.Next() {
+		var profile models.Profile
+		if err := rows.Scan(&profile.ID, &profile.Name, models.JSON{V: &profile.Settings}, models.JSON{V: &profile.Attrs}); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, &profile)
+	}
+	return profiles, rows.Err()
+}
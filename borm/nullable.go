@@ -0,0 +1,92 @@
+package borm
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (bo *BormORM) CreateNullableTable() error {
+	_, err := bo.db.Exec(`
+		CREATE TABLE IF NOT EXISTS nullable_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100),
+			email VARCHAR(100),
+			age INTEGER
+		)
+	`)
+	return err
+}
+
+func (bo *BormORM) DropNullableTable() error {
+	_, err := bo.db.Exec("DROP TABLE IF EXISTS nullable_users")
+	return err
+}
+
+func (bo *BormORM) InsertNullable(user *models.NullableUser) error {
+	result, err := bo.db.Exec(`INSERT INTO nullable_users (name, email, age) VALUES (?, ?, ?)`, user.Name, user.Email, user.Age)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+func (bo *BormORM) UpdateNullable(user *models.NullableUser) error {
+	_, err := bo.db.Exec(`UPDATE nullable_users SET name = ?, email = ?, age = ? WHERE id = ?`, user.Name, user.Email, user.Age, user.ID)
+	return err
+}
+
+func (bo *BormORM) GetNullableByID(id int64) (*models.NullableUser, error) {
+	user := &models.NullableUser{}
+	err := bo.db.QueryRow("SELECT id, name, email, age FROM nullable_users WHERE id = ?", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (bo *BormORM) GetNullableAll(limit, offset int) ([]*models.NullableUser, error) {
+	rows, err := bo.db.Query("SELECT id, name, email, age FROM nullable_users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.NullableUser
+	for rows.Next() {
+		var user models.NullableUser
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (bo *BormORM) GetPlainAll(limit, offset int) ([]*models.PlainUser, error) {
+	rows, err := bo.db.Query("SELECT id, name, email, age FROM nullable_users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.PlainUser
+	for rows.Next() {
+		var user models.PlainUser
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
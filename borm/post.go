@@ -0,0 +1,56 @@
+package borm
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (bo *BormORM) CreatePostTable() error {
+	_, err := bo.db.Exec(`
+		CREATE TABLE IF NOT EXISTS posts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			title VARCHAR(200) NOT NULL,
+			body TEXT NOT NULL
+		)
+	`)
+	return err
+}
+
+func (bo *BormORM) DropPostTable() error {
+	_, err := bo.db.Exec("DROP TABLE IF EXISTS posts")
+	return err
+}
+
+func (bo *BormORM) InsertPost(post *models.Post) error {
+	result, err := bo.db.Exec(`INSERT INTO posts (user_id, title, body) VALUES (?, ?, ?)`, post.UserID, post.Title, post.Body)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	post.ID = id
+	return nil
+}
+
+func (bo *BormORM) GetPostByID(id int64) (*models.Post, error) {
+	post := &models.Post{}
+	err := bo.db.QueryRow("SELECT id, user_id, title, body FROM posts WHERE id = ?", id).
+		Scan(&post.ID, &post.UserID, &post.Title, &post.Body)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("post not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (bo *BormORM) DeletePost(id int64) error {
+	_, err := bo.db.Exec("DELETE FROM posts WHERE id = ?", id)
+	return err
+}
@@ -0,0 +1,94 @@
+package borm
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (bo *BormORM) CreateSoftUserTable() error {
+	_, err := bo.db.Exec(`
+		CREATE TABLE IF NOT EXISTS soft_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME
+		)
+	`)
+	if err != nil {
+		return err
+	}
+	_, err = bo.db.Exec(`CREATE INDEX IF NOT EXISTS idx_soft_users_created_at ON soft_users (created_at)`)
+	if err != nil {
+		return err
+	}
+	_, err = bo.db.Exec(`CREATE INDEX IF NOT EXISTS idx_soft_users_deleted_at ON soft_users (deleted_at)`)
+	return err
+}
+
+func (bo *BormORM) DropSoftUserTable() error {
+	_, err := bo.db.Exec("DROP TABLE IF EXISTS soft_users")
+	return err
+}
+
+func (bo *BormORM) InsertSoftUser(user *models.User) error {
+	result, err := bo.db.Exec(`INSERT INTO soft_users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+// DeleteSoftUser 已删除的记录不再更新，保留第一次删除的时间
+func (bo *BormORM) DeleteSoftUser(id int64) error {
+	_, err := bo.db.Exec("UPDATE soft_users SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL", time.Now().UTC(), id)
+	return err
+}
+
+func (bo *BormORM) GetSoftUserByID(id int64) (*models.User, error) {
+	user := &models.User{}
+	err := bo.db.QueryRow("SELECT id, name, email, age, created_at, updated_at FROM soft_users WHERE id = ? AND deleted_at IS NULL", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (bo *BormORM) CountSoftUsers() (int64, error) {
+	var count int64
+	err := bo.db.QueryRow("SELECT COUNT(*) FROM soft_users WHERE deleted_at IS NULL").Scan(&count)
+	return count, err
+}
+
+func (bo *BormORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	rows, err := bo.db.Query("SELECT id, name, email, age, created_at, updated_at FROM soft_users WHERE deleted_at IS NULL ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*models.User, 0, limit)
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,72 @@
+package borm
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+func (bo *BormORM) CreateVersionedTable() error {
+	_, err := bo.db.Exec(`
+		CREATE TABLE IF NOT EXISTS versioned_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			version INTEGER NOT NULL
+		)
+	`)
+	return err
+}
+
+func (bo *BormORM) DropVersionedTable() error {
+	_, err := bo.db.Exec("DROP TABLE IF EXISTS versioned_users")
+	return err
+}
+
+func (bo *BormORM) InsertVersioned(user *models.VersionedUser) error {
+	result, err := bo.db.Exec(`INSERT INTO versioned_users (name, email, age, version) VALUES (?, ?, ?, 1)`,
+		user.Name, user.Email, user.Age)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	user.Version = 1
+	return nil
+}
+
+func (bo *BormORM) UpdateIfVersion(user *models.VersionedUser, expectedVersion int64) error {
+	result, err := bo.db.Exec(`UPDATE versioned_users SET name = ?, email = ?, age = ?, version = version + 1 WHERE id = ? AND version = ?`,
+		user.Name, user.Email, user.Age, user.ID, expectedVersion)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return orm.ErrVersionConflict
+	}
+	user.Version = expectedVersion + 1
+	return nil
+}
+
+func (bo *BormORM) GetVersionedByID(id int64) (*models.VersionedUser, error) {
+	user := &models.VersionedUser{}
+	err := bo.db.QueryRow("SELECT id, name, email, age, version FROM versioned_users WHERE id = ?", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.Version)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,52 @@
+package borm
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (bo *BormORM) CreateWideTables() error {
+	for _, n := range models.WideWidths {
+		if _, err := bo.db.Exec(models.WideDDL(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bo *BormORM) DropWideTables() error {
+	for _, n := range models.WideWidths {
+		if _, err := bo.db.Exec("DROP TABLE IF EXISTS " + models.NewWide(n).TableName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bo *BormORM) InsertWide(row models.Wide) error {
+	result, err := bo.db.Exec(models.WideInsertSQL(len(row.Columns())), row.Values()...)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	row.SetID(id)
+	return nil
+}
+
+func (bo *BormORM) GetWide(cols, limit, offset int) ([]models.Wide, error) {
+	rows, err := bo.db.Query(models.WideSelectSQL(cols), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]models.Wide, 0, limit)
+	for rows.Next() {
+		row := models.NewWide(cols)
+		if err := rows.Scan(row.Pointers()...); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
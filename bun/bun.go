@@ -5,16 +5,20 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/benchplus/goorm/internal/models"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/benchplus/goorm/internal/sqldriver"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/sqlitedialect"
 )
 
 type BunORM struct {
-	db  *bun.DB
+	db *bun.DB
+	// ctx 固定为 context.Background()，创建后只读，可在多个 goroutine 间共享
 	ctx context.Context
+	// hookDB 挂载了查询钩子的 db 副本，只用于 hook_users，由 CreateHookTable 创建
+	hookDB *bun.DB
 }
 
 func New() *BunORM {
@@ -24,7 +28,7 @@ func New() *BunORM {
 }
 
 func (b *BunORM) Init(dsn string) error {
-	sqldb, err := sql.Open("sqlite3", dsn)
+	sqldb, err := sql.Open(sqldriver.Name(), dsn)
 	if err != nil {
 		return err
 	}
@@ -42,6 +46,16 @@ func (b *BunORM) CreateTable() error {
 		Model((*models.User)(nil)).
 		IfNotExists().
 		Exec(b.ctx)
+	if err != nil {
+		return err
+	}
+	// BUN 的建表不会根据 tag 创建索引
+	_, err = b.db.NewCreateIndex().
+		Model((*models.User)(nil)).
+		Index("idx_users_created_at").
+		Column("created_at").
+		IfNotExists().
+		Exec(b.ctx)
 	return err
 }
 
@@ -126,12 +140,51 @@ func (b *BunORM) GetAll(limit, offset int) ([]*models.User, error) {
 	var users []*models.User
 	err := b.db.NewSelect().
 		Model(&users).
+		Order("id").
 		Limit(limit).
 		Offset(offset).
 		Scan(b.ctx)
 	return users, err
 }
 
+func (b *BunORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	var users []*models.User
+	err := b.db.NewSelect().
+		Model(&users).
+		Where("created_at >= ?", from).
+		Where("created_at < ?", to).
+		Order("created_at").
+		Scan(b.ctx)
+	return users, err
+}
+
+func (b *BunORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	var users []*models.User
+	err := b.db.NewRaw(query, args...).Scan(b.ctx, &users)
+	return users, err
+}
+
+func (b *BunORM) Iterate(fn func(*models.User) error) error {
+	rows, err := b.db.NewSelect().
+		Model((*models.User)(nil)).
+		Rows(b.ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user models.User
+		if err := b.db.ScanRow(b.ctx, rows, &user); err != nil {
+			return err
+		}
+		if err := fn(&user); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 // GetDSN 生成测试用的 DSN
 func GetDSN() string {
 	return fmt.Sprintf("file:%s?cache=shared&mode=memory", getTempFile())
@@ -0,0 +1,91 @@
+package bun
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// hookCalls hookUser 的模型钩子和 hookQuery 被调用的累计次数，由本包所有实例共享
+var hookCalls atomic.Int64
+
+// hookUser 与 models.User 结构相同、定义了 BUN 模型钩子的模型，钩子只计数。
+// BUN 按类型名推导表名 hook_users，与 models.HookTable 一致
+type hookUser models.User
+
+// BeforeAppendModel 插入和更新在生成 SQL 前调用
+func (*hookUser) BeforeAppendModel(context.Context, bun.Query) error {
+	hookCalls.Add(1)
+	return nil
+}
+
+func (*hookUser) BeforeScanRow(context.Context) error {
+	hookCalls.Add(1)
+	return nil
+}
+
+func (*hookUser) AfterScanRow(context.Context) error {
+	hookCalls.Add(1)
+	return nil
+}
+
+// hookQuery 查询钩子，每条语句执行前后各计数一次
+type hookQuery struct{}
+
+func (hookQuery) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	hookCalls.Add(1)
+	return ctx
+}
+
+func (hookQuery) AfterQuery(context.Context, *bun.QueryEvent) {
+	hookCalls.Add(1)
+}
+
+func (b *BunORM) CreateHookTable() error {
+	// 查询钩子挂在 db 的副本上，不影响其他测试的语句
+	b.hookDB = b.db.WithQueryHook(hookQuery{})
+	_, err := b.hookDB.NewCreateTable().
+		Model((*hookUser)(nil)).
+		IfNotExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) DropHookTable() error {
+	_, err := b.hookDB.NewDropTable().
+		Model((*hookUser)(nil)).
+		IfExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) InsertHooked(user *models.User) error {
+	_, err := b.hookDB.NewInsert().Model((*hookUser)(user)).Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) UpdateHooked(user *models.User) error {
+	_, err := b.hookDB.NewUpdate().
+		Model((*hookUser)(user)).
+		Where("id = ?", user.ID).
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetHookedByID(id int64) (*models.User, error) {
+	user := &hookUser{}
+	err := b.hookDB.NewSelect().
+		Model(user).
+		Where("id = ?", id).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return (*models.User)(user), nil
+}
+
+func (b *BunORM) HookCalls() int64 {
+	return hookCalls.Load()
+}
@@ -0,0 +1,46 @@
+package bun
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (b *BunORM) CreateJSONTable() error {
+	_, err := b.db.NewCreateTable().
+		Model((*models.Profile)(nil)).
+		IfNotExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) DropJSONTable() error {
+	_, err := b.db.NewDropTable().
+		Model((*models.Profile)(nil)).
+		IfExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) InsertProfile(profile *models.Profile) error {
+	_, err := b.db.NewInsert().Model(profile).Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetProfileByID(id int64) (*models.Profile, error) {
+	profile := &models.Profile{}
+	err := b.db.NewSelect().
+		Model(profile).
+		Where("id = ?", id).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (b *BunORM) GetProfilesByTheme(theme string) ([]*models.Profile, error) {
+	var profiles []*models.Profile
+	err := b.db.NewSelect().
+		Model(&profiles).
+		Where("json_extract(settings, '$.theme') = ?", theme).
+		Order("id").
+		Scan(b.ctx)
+	return profiles, err
+}
@@ -0,0 +1,99 @@
+package bun
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
+
+func (b *BunORM) CreateKeyTables() error {
+	for _, model := range []interface{}{(*models.UUIDUser)(nil), (*models.SnowflakeUser)(nil)} {
+		_, err := b.db.NewCreateTable().
+			Model(model).
+			IfNotExists().
+			Exec(b.ctx)
+		if err != nil {
+			return err
+		}
+	}
+	// BUN 的建表不会根据 tag 创建索引
+	_, err := b.db.NewCreateIndex().
+		Model((*models.UUIDUser)(nil)).
+		Index("idx_uuid_users_created_at").
+		Column("created_at").
+		IfNotExists().
+		Exec(b.ctx)
+	if err != nil {
+		return err
+	}
+	_, err = b.db.NewCreateIndex().
+		Model((*models.SnowflakeUser)(nil)).
+		Index("idx_snowflake_users_created_at").
+		Column("created_at").
+		IfNotExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) DropKeyTables() error {
+	for _, model := range []interface{}{(*models.UUIDUser)(nil), (*models.SnowflakeUser)(nil)} {
+		_, err := b.db.NewDropTable().
+			Model(model).
+			IfExists().
+			Exec(b.ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BunORM) InsertUUIDUser(user *models.UUIDUser) error {
+	_, err := b.db.NewInsert().Model(user).Exec(b.ctx)
+	return err
+}
+
+// InsertUUIDBatch 主键由客户端生成，不需要 Returning
+func (b *BunORM) InsertUUIDBatch(users []*models.UUIDUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	_, err := b.db.NewInsert().Model(&users).Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error) {
+	user := &models.UUIDUser{}
+	err := b.db.NewSelect().
+		Model(user).
+		Where("id = ?", id).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (b *BunORM) InsertSnowflakeUser(user *models.SnowflakeUser) error {
+	_, err := b.db.NewInsert().Model(user).Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) InsertSnowflakeBatch(users []*models.SnowflakeUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	_, err := b.db.NewInsert().Model(&users).Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error) {
+	user := &models.SnowflakeUser{}
+	err := b.db.NewSelect().
+		Model(user).
+		Where("id = ?", id).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
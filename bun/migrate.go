@@ -0,0 +1,97 @@
+package bun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+)
+
+// bunMigrationTables bun/migrate 记录已执行迁移和加锁的表
+var bunMigrationTables = []string{"bun_migrations", "bun_migration_locks"}
+
+func (b *BunORM) CreateMigrateTable() error {
+	_, err := b.db.NewCreateTable().
+		Model((*models.MigrateUser)(nil)).
+		Exec(b.ctx)
+	if err != nil {
+		return err
+	}
+	// 迁移记录表在部署前已存在，不计入迁移耗时
+	return migrate.NewMigrator(b.db, migrate.NewMigrations()).Init(b.ctx)
+}
+
+func (b *BunORM) DropMigrateTable() error {
+	// SQLite 的 DROP TABLE 只接受一个表名
+	for _, table := range append([]string{models.MigrateTable}, bunMigrationTables...) {
+		if _, err := b.db.NewDropTable().Table(table).IfExists().Exec(b.ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate 注册该步骤的 Go 迁移并由 migrate.Migrator 执行，迁移记录写入 bun_migrations。
+// sqlitedialect 不支持 AutoMigrator 的结构比对，变更由迁移函数用查询构造器写出
+func (b *BunORM) Migrate(step models.MigrateStep) error {
+	var up migrate.MigrationFunc
+	switch step {
+	case models.MigrateAddColumn:
+		up = func(ctx context.Context, db *bun.DB) error {
+			_, err := db.NewAddColumn().
+				Model((*models.MigrateUserNickname)(nil)).
+				ModelTableExpr(models.MigrateTable).
+				ColumnExpr("nickname VARCHAR(50)").
+				Exec(ctx)
+			return err
+		}
+	case models.MigrateAddIndex:
+		up = func(ctx context.Context, db *bun.DB) error {
+			_, err := db.NewCreateIndex().
+				Model((*models.MigrateUserIndexed)(nil)).
+				ModelTableExpr(models.MigrateTable).
+				Index("idx_migrate_users_email").
+				Column("email").
+				Exec(ctx)
+			return err
+		}
+	case models.MigrateWidenColumn:
+		up = widenMigrateName
+	default:
+		return fmt.Errorf("unknown migration step: %v", step)
+	}
+
+	migrations := migrate.NewMigrations()
+	migrations.Add(migrate.Migration{
+		Name:    fmt.Sprintf("%014d", int(step)+1),
+		Comment: step.String(),
+		Up: func(ctx context.Context, m *migrate.Migrator, _ *migrate.Migration) error {
+			return up(ctx, m.DB())
+		},
+	})
+	_, err := migrate.NewMigrator(b.db, migrations).Migrate(b.ctx)
+	return err
+}
+
+// widenMigrateName SQLite 不支持修改列类型，在事务中新建加宽后的表、复制数据后替换原表
+func widenMigrateName(ctx context.Context, db *bun.DB) error {
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewCreateTable().
+			Model((*models.MigrateUserWide)(nil)).
+			ModelTableExpr("migrate_users_new").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("INSERT INTO migrate_users_new (id, name, email, age) SELECT id, name, email, age FROM ?",
+			bun.Ident(models.MigrateTable)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewDropTable().Table(models.MigrateTable).Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.NewRaw("ALTER TABLE migrate_users_new RENAME TO ?", bun.Ident(models.MigrateTable)).Exec(ctx)
+		return err
+	})
+}
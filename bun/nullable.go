@@ -0,0 +1,67 @@
+package bun
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (b *BunORM) CreateNullableTable() error {
+	_, err := b.db.NewCreateTable().
+		Model((*models.NullableUser)(nil)).
+		IfNotExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) DropNullableTable() error {
+	_, err := b.db.NewDropTable().
+		Model((*models.NullableUser)(nil)).
+		IfExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) InsertNullable(user *models.NullableUser) error {
+	_, err := b.db.NewInsert().Model(user).Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) UpdateNullable(user *models.NullableUser) error {
+	_, err := b.db.NewUpdate().
+		Model(user).
+		Where("id = ?", user.ID).
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetNullableByID(id int64) (*models.NullableUser, error) {
+	user := &models.NullableUser{}
+	err := b.db.NewSelect().
+		Model(user).
+		Where("id = ?", id).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (b *BunORM) GetNullableAll(limit, offset int) ([]*models.NullableUser, error) {
+	var users []*models.NullableUser
+	err := b.db.NewSelect().
+		Model(&users).
+		Order("id").
+		Limit(limit).
+		Offset(offset).
+		Scan(b.ctx)
+	return users, err
+}
+
+func (b *BunORM) GetPlainAll(limit, offset int) ([]*models.PlainUser, error) {
+	var users []*models.PlainUser
+	err := b.db.NewSelect().
+		Model(&users).
+		ModelTableExpr("nullable_users AS plain_user").
+		Order("id").
+		Limit(limit).
+		Offset(offset).
+		Scan(b.ctx)
+	return users, err
+}
@@ -0,0 +1,44 @@
+package bun
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (b *BunORM) CreatePostTable() error {
+	_, err := b.db.NewCreateTable().
+		Model((*models.Post)(nil)).
+		IfNotExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) DropPostTable() error {
+	_, err := b.db.NewDropTable().
+		Model((*models.Post)(nil)).
+		IfExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) InsertPost(post *models.Post) error {
+	_, err := b.db.NewInsert().Model(post).Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetPostByID(id int64) (*models.Post, error) {
+	post := &models.Post{}
+	err := b.db.NewSelect().
+		Model(post).
+		Where("id = ?", id).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (b *BunORM) DeletePost(id int64) error {
+	_, err := b.db.NewDelete().
+		Model((*models.Post)(nil)).
+		Where("id = ?", id).
+		Exec(b.ctx)
+	return err
+}
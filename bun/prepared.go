@@ -0,0 +1,126 @@
+package bun
+
+import (
+	"database/sql"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// PreparedBunORM 在 BunORM 基础上用 DB.Prepare 预编译常用语句，结果仍由 BUN 的 ScanRow/ScanRows 映射。
+// BUN 的查询构造器会把参数内联进 SQL 文本，无法复用预编译语句，因此这里直接写 SQL；
+// 未覆盖的方法沿用 BunORM 的实现。
+type PreparedBunORM struct {
+	*BunORM
+
+	insert  bun.Stmt
+	update  bun.Stmt
+	getByID bun.Stmt
+	del     bun.Stmt
+	count   bun.Stmt
+	getAll  bun.Stmt
+}
+
+// NewPrepared 创建使用预编译语句的实现，语句在 CreateTable 之后编译
+func NewPrepared() *PreparedBunORM {
+	return &PreparedBunORM{BunORM: New()}
+}
+
+func (b *PreparedBunORM) CreateTable() error {
+	if err := b.BunORM.CreateTable(); err != nil {
+		return err
+	}
+	if b.insert.Stmt != nil {
+		return nil
+	}
+
+	stmts := []struct {
+		stmt  *bun.Stmt
+		query string
+	}{
+		{&b.insert, `INSERT INTO users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`},
+		{&b.update, `UPDATE users SET name = ?, email = ?, age = ?, created_at = ?, updated_at = ? WHERE id = ?`},
+		{&b.getByID, "SELECT id, name, email, age, created_at, updated_at FROM users WHERE id = ?"},
+		{&b.del, "DELETE FROM users WHERE id = ?"},
+		{&b.count, "SELECT COUNT(*) FROM users"},
+		{&b.getAll, "SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?"},
+	}
+	for _, s := range stmts {
+		stmt, err := b.db.PrepareContext(b.ctx, s.query)
+		if err != nil {
+			return err
+		}
+		*s.stmt = stmt
+	}
+	return nil
+}
+
+func (b *PreparedBunORM) Close() error {
+	for _, stmt := range []bun.Stmt{b.insert, b.update, b.getByID, b.del, b.count, b.getAll} {
+		if stmt.Stmt != nil {
+			stmt.Close()
+		}
+	}
+	return b.BunORM.Close()
+}
+
+func (b *PreparedBunORM) Insert(user *models.User) error {
+	result, err := b.insert.ExecContext(b.ctx, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+func (b *PreparedBunORM) GetByID(id int64) (*models.User, error) {
+	rows, err := b.getByID.QueryContext(b.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, sql.ErrNoRows
+	}
+	user := &models.User{}
+	if err := b.db.ScanRow(b.ctx, rows, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (b *PreparedBunORM) Update(user *models.User) error {
+	_, err := b.update.ExecContext(b.ctx, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC(), user.ID)
+	return err
+}
+
+func (b *PreparedBunORM) Delete(id int64) error {
+	_, err := b.del.ExecContext(b.ctx, id)
+	return err
+}
+
+func (b *PreparedBunORM) Count() (int64, error) {
+	var count int64
+	err := b.count.QueryRowContext(b.ctx).Scan(&count)
+	return count, err
+}
+
+func (b *PreparedBunORM) GetAll(limit, offset int) ([]*models.User, error) {
+	rows, err := b.getAll.QueryContext(b.ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	var users []*models.User
+	if err := b.db.ScanRows(b.ctx, rows, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
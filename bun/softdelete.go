@@ -0,0 +1,99 @@
+package bun
+
+import (
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// softUser 嵌入 models.User 并带 soft_delete 字段：NewDelete 改为设置 deleted_at，
+// 查询和计数自动追加 deleted_at IS NULL。BUN 按类型名推导表名 soft_users，与 models.SoftDeleteTable 一致
+type softUser struct {
+	models.User
+	DeletedAt time.Time `bun:"deleted_at,soft_delete,nullzero"`
+}
+
+func (b *BunORM) CreateSoftUserTable() error {
+	_, err := b.db.NewCreateTable().
+		Model((*softUser)(nil)).
+		IfNotExists().
+		Exec(b.ctx)
+	if err != nil {
+		return err
+	}
+	// BUN 的建表不会根据 tag 创建索引
+	for _, column := range []string{"created_at", "deleted_at"} {
+		_, err = b.db.NewCreateIndex().
+			Model((*softUser)(nil)).
+			Index("idx_soft_users_" + column).
+			Column(column).
+			IfNotExists().
+			Exec(b.ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BunORM) DropSoftUserTable() error {
+	_, err := b.db.NewDropTable().
+		Model((*softUser)(nil)).
+		IfExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) InsertSoftUser(user *models.User) error {
+	row := &softUser{User: *user}
+	if _, err := b.db.NewInsert().Model(row).Exec(b.ctx); err != nil {
+		return err
+	}
+	user.ID = row.ID
+	return nil
+}
+
+func (b *BunORM) DeleteSoftUser(id int64) error {
+	_, err := b.db.NewDelete().
+		Model((*softUser)(nil)).
+		Where("id = ?", id).
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetSoftUserByID(id int64) (*models.User, error) {
+	row := &softUser{}
+	err := b.db.NewSelect().
+		Model(row).
+		Where("id = ?", id).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &row.User, nil
+}
+
+func (b *BunORM) CountSoftUsers() (int64, error) {
+	count, err := b.db.NewSelect().
+		Model((*softUser)(nil)).
+		Count(b.ctx)
+	return int64(count), err
+}
+
+func (b *BunORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	var rows []softUser
+	err := b.db.NewSelect().
+		Model(&rows).
+		Order("id").
+		Limit(limit).
+		Offset(offset).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*models.User, len(rows))
+	for i := range rows {
+		users[i] = &rows[i].User
+	}
+	return users, nil
+}
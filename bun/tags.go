@@ -0,0 +1,117 @@
+package bun
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// taggedUser 映射 users 表，Tags 经 user_tags 连接表多对多关联
+type taggedUser struct {
+	bun.BaseModel `bun:"table:users,alias:user"`
+	models.User
+	Tags []*models.Tag `bun:"m2m:user_tags,join:User=Tag"`
+}
+
+// userTag user_tags 连接表，BUN 的 m2m 关联要求连接表模型带两端的 belongs-to 关联
+type userTag struct {
+	bun.BaseModel `bun:"table:user_tags,alias:user_tag"`
+	UserID        int64       `bun:"user_id,pk"`
+	User          *taggedUser `bun:"rel:belongs-to,join:user_id=id"`
+	TagID         int64       `bun:"tag_id,pk"`
+	Tag           *models.Tag `bun:"rel:belongs-to,join:tag_id=id"`
+}
+
+func (b *BunORM) CreateTagTables() error {
+	b.db.RegisterModel((*userTag)(nil))
+	for _, model := range []interface{}{(*models.Tag)(nil), (*userTag)(nil)} {
+		_, err := b.db.NewCreateTable().
+			Model(model).
+			IfNotExists().
+			Exec(b.ctx)
+		if err != nil {
+			return err
+		}
+	}
+	// BUN 的建表不会根据 tag 创建索引
+	_, err := b.db.NewCreateIndex().
+		Model((*userTag)(nil)).
+		Index("idx_user_tags_tag_id").
+		Column("tag_id").
+		IfNotExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) DropTagTables() error {
+	_, err := b.db.NewDropTable().
+		Model((*userTag)(nil)).
+		IfExists().
+		Exec(b.ctx)
+	if err != nil {
+		return err
+	}
+	_, err = b.db.NewDropTable().
+		Model((*models.Tag)(nil)).
+		IfExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) InsertTags(tags []*models.Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	return b.db.NewInsert().
+		Model(&tags).
+		Returning("id").
+		Scan(b.ctx)
+}
+
+// AttachTags BUN 没有关联写入的 API，直接插入连接表模型
+func (b *BunORM) AttachTags(userID int64, tagIDs []int64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	rows := make([]userTag, len(tagIDs))
+	for i, tagID := range tagIDs {
+		rows[i] = userTag{UserID: userID, TagID: tagID}
+	}
+	_, err := b.db.NewInsert().
+		Model(&rows).
+		On("CONFLICT DO NOTHING").
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetUsersWithTags(limit, offset int) ([]*models.TaggedUser, error) {
+	var rows []*taggedUser
+	err := b.db.NewSelect().
+		Model(&rows).
+		Relation("Tags", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("tag.id")
+		}).
+		Order("user.id").
+		Limit(limit).
+		Offset(offset).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*models.TaggedUser, len(rows))
+	for i, row := range rows {
+		users[i] = &models.TaggedUser{User: row.User, Tags: row.Tags}
+	}
+	return users, nil
+}
+
+func (b *BunORM) GetUsersByTag(name string) ([]*models.User, error) {
+	var users []*models.User
+	err := b.db.NewSelect().
+		Model(&users).
+		Join("JOIN user_tags AS ut ON ut.user_id = ?TableAlias.id").
+		Join("JOIN tags AS t ON t.id = ut.tag_id").
+		Where("t.name = ?", name).
+		Order("user.id").
+		Scan(b.ctx)
+	return users, err
+}
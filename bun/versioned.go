@@ -0,0 +1,63 @@
+package bun
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+func (b *BunORM) CreateVersionedTable() error {
+	_, err := b.db.NewCreateTable().
+		Model((*models.VersionedUser)(nil)).
+		IfNotExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) DropVersionedTable() error {
+	_, err := b.db.NewDropTable().
+		Model((*models.VersionedUser)(nil)).
+		IfExists().
+		Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) InsertVersioned(user *models.VersionedUser) error {
+	user.Version = 1
+	_, err := b.db.NewInsert().Model(user).Exec(b.ctx)
+	return err
+}
+
+// UpdateIfVersion BUN 没有乐观锁支持，以 version = ? 条件更新并按影响行数判断冲突
+func (b *BunORM) UpdateIfVersion(user *models.VersionedUser, expectedVersion int64) error {
+	result, err := b.db.NewUpdate().
+		Model(user).
+		Column("name", "email", "age").
+		Set("version = version + 1").
+		Where("id = ?", user.ID).
+		Where("version = ?", expectedVersion).
+		Exec(b.ctx)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return orm.ErrVersionConflict
+	}
+	user.Version = expectedVersion + 1
+	return nil
+}
+
+func (b *BunORM) GetVersionedByID(id int64) (*models.VersionedUser, error) {
+	user := &models.VersionedUser{}
+	err := b.db.NewSelect().
+		Model(user).
+		Where("id = ?", id).
+		Scan(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
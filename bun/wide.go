@@ -0,0 +1,45 @@
+package bun
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (b *BunORM) CreateWideTables() error {
+	for _, n := range models.WideWidths {
+		_, err := b.db.NewCreateTable().
+			Model(models.NewWide(n)).
+			IfNotExists().
+			Exec(b.ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BunORM) DropWideTables() error {
+	for _, n := range models.WideWidths {
+		_, err := b.db.NewDropTable().
+			Model(models.NewWide(n)).
+			IfExists().
+			Exec(b.ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BunORM) InsertWide(row models.Wide) error {
+	_, err := b.db.NewInsert().Model(row).Exec(b.ctx)
+	return err
+}
+
+func (b *BunORM) GetWide(cols, limit, offset int) ([]models.Wide, error) {
+	rows := models.NewWideSlice(cols)
+	err := b.db.NewSelect().
+		Model(rows).
+		Order("id").
+		Limit(limit).
+		Offset(offset).
+		Scan(b.ctx)
+	return models.WideRows(rows), err
+}
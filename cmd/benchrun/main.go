@@ -0,0 +1,250 @@
+// benchrun 运行基准测试并生成 Markdown 报告。
+//
+// 用法：
+//
+//	go run ./cmd/benchrun                                # 运行全部基准测试
+//	go run ./cmd/benchrun -bench 'InsertSingle|GetByID'  # 只运行匹配的用例
+//	go run ./cmd/benchrun -input bench_output.txt        # 根据已有的 go test -bench 输出生成报告
+//	go run ./cmd/benchrun -latency ''                    # 不运行模拟延迟
+//	go run ./cmd/benchrun -null=false                    # 不运行空驱动
+//	go run ./cmd/benchrun -trace=false                   # 不统计语句数
+//	go run ./cmd/benchrun -profile=false                 # 不采集 profile
+//	go run ./cmd/benchrun -gogc '' -memlimit ''          # 不运行其他 GC 设置
+//
+// 原始输出写入 <out>/bench.txt，报告写入 <out>/report.md。计时运行使用不计数的 sqlite3 驱动；
+// 再以 sqlite3+trace 驱动运行一遍，输出写入 <out>/bench-trace.txt，报告中的语句数和往返次数取自这一遍；
+// -trace=false 时跳过。
+// -latency 中的每个延迟再以 sqlite3+latency 驱动运行一遍，输出写入 <out>/bench-latency-<延迟>.txt，
+// 报告中按延迟分别给出汇总表。
+// 再以不执行 SQL 的 null 驱动运行一遍，输出写入 <out>/bench-null.txt，报告据此把每个实现的耗时
+// 拆分为 ORM、驱动和数据库引擎三部分；-null=false 时跳过。
+// -gogc 中的每个值和 -memlimit 再各运行一遍，输出写入 <out>/bench-gogc-<值>.txt 和 <out>/bench-gomemlimit-<值>.txt，
+// 报告中给出各实现相对默认运行（GOGC=100，无内存上限）的变慢倍数。
+// 然后逐个实现×用例单独运行并采集 CPU 和内存分配 profile，保存到 <out>/profiles/，
+// 报告中按包（ORM、database/sql、go-sqlite3、reflect、runtime 等）给出占比；-profile=false 时跳过。
+// 之后再以 -benchtime 1x 运行一遍匹配的基准测试，把每个基准测试单次操作发出的 SQL
+// 写到 <out>/sql/<Case>_<ORM>.sql；-sql=false 时跳过。
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+func main() {
+	bench := flag.String("bench", ".", "基准测试名称正则，传给 go test -bench")
+	benchtime := flag.String("benchtime", "", "传给 go test -benchtime")
+	count := flag.Int("count", 1, "每个基准测试的运行次数，结果取平均")
+	out := flag.String("out", "results", "输出目录")
+	input := flag.String("input", "", "已有的 go test -bench 输出文件；指定时不运行基准测试")
+	transcripts := flag.Bool("sql", true, "额外运行一遍基准测试，记录每个用例发出的 SQL")
+	latencyList := flag.String("latency", "200us,1ms", "逗号分隔的模拟往返延迟，每个值额外运行一遍基准测试；为空时不运行")
+	jitter := flag.String("jitter", "", "模拟延迟的抖动幅度，如 50us")
+	traceRun := flag.Bool("trace", true, "额外以 sqlite3+trace 驱动运行一遍基准测试，统计每次操作的语句数和往返次数")
+	nullRun := flag.Bool("null", true, "额外以返回预制结果的 null 驱动运行一遍基准测试，拆分 ORM、驱动和引擎的耗时")
+	profiling := flag.Bool("profile", true, "逐个基准测试采集 CPU 和内存分配 profile，按包汇总")
+	gogcList := flag.String("gogc", "50,400", "逗号分隔的 GOGC 值，每个值额外运行一遍基准测试；为空时不运行")
+	memlimit := flag.String("memlimit", "10MiB", "额外以该 GOMEMLIMIT 运行一遍基准测试；为空时不运行")
+	flag.Parse()
+
+	latencies, err := parseLatencies(*latencyList)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	raw := *input
+	if raw == "" {
+		raw = filepath.Join(*out, "bench.txt")
+		if err := runBenchmarks(raw, *bench, *benchtime, *count, nil); err != nil {
+			log.Fatal(err)
+		}
+		if *transcripts {
+			if err := runTranscripts(filepath.Join(*out, "sql"), *bench); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+
+	results, err := readResults(raw)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	runs := Runs{Results: results}
+	if *input == "" && *traceRun {
+		path := filepath.Join(*out, "bench-trace.txt")
+		env := []string{sqldriver.DriverEnv + "=" + sqldriver.DriverName}
+		if err := runBenchmarks(path, *bench, *benchtime, 1, env); err != nil {
+			log.Fatal(err)
+		}
+		if runs.Trace, err = readResults(path); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *input == "" {
+		for _, latency := range latencies {
+			path := filepath.Join(*out, "bench-latency-"+strings.ReplaceAll(latency.String(), "µ", "u")+".txt")
+			env := []string{
+				sqldriver.DriverEnv + "=" + sqldriver.LatencyDriverName,
+				sqldriver.LatencyEnv + "=" + latency.String(),
+				sqldriver.JitterEnv + "=" + *jitter,
+			}
+			if err := runBenchmarks(path, *bench, *benchtime, *count, env); err != nil {
+				log.Fatal(err)
+			}
+			latencyResults, err := readResults(path)
+			if err != nil {
+				log.Fatal(err)
+			}
+			runs.Latencies = append(runs.Latencies, LatencyRun{Latency: latency, Results: latencyResults})
+		}
+
+		for _, setting := range gcSettings(*gogcList, *memlimit) {
+			name, value, _ := strings.Cut(setting, "=")
+			path := filepath.Join(*out, "bench-"+strings.ToLower(name)+"-"+value+".txt")
+			if err := runBenchmarks(path, *bench, *benchtime, *count, []string{setting}); err != nil {
+				log.Fatal(err)
+			}
+			gcResults, err := readResults(path)
+			if err != nil {
+				log.Fatal(err)
+			}
+			runs.GC = append(runs.GC, GCRun{Setting: setting, Results: gcResults})
+		}
+	}
+
+	if *input == "" && *nullRun {
+		path := filepath.Join(*out, "bench-null.txt")
+		env := []string{sqldriver.DriverEnv + "=" + sqldriver.NullDriverName}
+		if err := runBenchmarks(path, *bench, *benchtime, *count, env); err != nil {
+			log.Fatal(err)
+		}
+		if runs.Null, err = readResults(path); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if *input == "" && *profiling {
+		if runs.Profiles, err = runProfiles(filepath.Join(*out, "profiles"), *benchtime, results); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	report := filepath.Join(*out, "report.md")
+	rf, err := os.Create(report)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := WriteReport(rf, runs); err != nil {
+		rf.Close()
+		log.Fatal(err)
+	}
+	if err := rf.Close(); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("report written to %s\n", report)
+}
+
+// parseLatencies 解析逗号分隔的延迟列表，0 与默认运行相同，跳过
+func parseLatencies(list string) ([]time.Duration, error) {
+	var latencies []time.Duration
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		d, err := time.ParseDuration(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latency %q: %v", field, err)
+		}
+		if d > 0 {
+			latencies = append(latencies, d)
+		}
+	}
+	return latencies, nil
+}
+
+// gcSettings 返回额外运行的 GC 环境变量设置：每个 GOGC 值一项，memlimit 非空时再加一项 GOMEMLIMIT
+func gcSettings(gogcList, memlimit string) []string {
+	var settings []string
+	for _, field := range strings.Split(gogcList, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			settings = append(settings, "GOGC="+field)
+		}
+	}
+	if memlimit = strings.TrimSpace(memlimit); memlimit != "" {
+		settings = append(settings, "GOMEMLIMIT="+memlimit)
+	}
+	return settings
+}
+
+// readResults 解析 go test -bench 输出文件
+func readResults(path string) ([]Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	results, err := Parse(f)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no benchmark results in %s", path)
+	}
+	return results, nil
+}
+
+// runBenchmarks 在当前目录运行 go test -bench，输出同时写到标准输出和 path；env 追加到环境变量
+func runBenchmarks(path, bench, benchtime string, count int, env []string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	args := []string{"test", "-run", "^$", "-bench", bench, "-benchmem", "-count", fmt.Sprint(count), "-timeout", "0"}
+	if benchtime != "" {
+		args = append(args, "-benchtime", benchtime)
+	}
+	args = append(args, ".")
+
+	cmd := exec.Command("go", args...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = io.MultiWriter(os.Stdout, f)
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// runTranscripts 以 -benchtime 1x 运行匹配的基准测试，由测试把 SQL 记录写到 dir。
+// 单次操作包含首次执行时的预编译，计时结果不使用
+func runTranscripts(dir, bench string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command("go", "test", "-run", "^$", "-bench", bench, "-benchtime", "1x", "-timeout", "0", ".")
+	cmd.Env = append(os.Environ(), "GOORM_SQL_DIR="+abs, sqldriver.DriverEnv+"="+sqldriver.DriverName)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	fmt.Printf("SQL transcripts written to %s\n", dir)
+	return nil
+}
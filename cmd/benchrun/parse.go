@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Result 一条基准测试结果
+type Result struct {
+	// Case 用例名，包含子测试路径，如 PostInsert/1KB
+	Case string
+	// ORM 注册表中的实现名，如 gorm、stdlib+prepared
+	ORM string
+	// N 迭代次数
+	N int
+	// Metrics 单位到数值的映射，如 ns/op、B/op 及 b.ReportMetric 报告的自定义指标
+	Metrics map[string]float64
+}
+
+// Parse 解析 go test -bench 的输出。
+//
+// 基准测试按 Benchmark<Case>_<ORM>[_<VARIANT>][/<sub>] 命名，
+// ORM 名还原为注册表中的写法：小写，变体之间用 + 连接（STDLIB_PREPARED -> stdlib+prepared）。
+func Parse(r io.Reader) ([]Result, error) {
+	var results []Result
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if res, ok := parseLine(scanner.Text()); ok {
+			results = append(results, res)
+		}
+	}
+	return results, scanner.Err()
+}
+
+func parseLine(line string) (Result, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return Result{}, false
+	}
+	n, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return Result{}, false
+	}
+	caseName, ormName, ok := splitName(strings.TrimPrefix(fields[0], "Benchmark"))
+	if !ok {
+		return Result{}, false
+	}
+
+	res := Result{Case: caseName, ORM: ormName, N: n, Metrics: make(map[string]float64)}
+	for i := 2; i+1 < len(fields); i += 2 {
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return Result{}, false
+		}
+		res.Metrics[fields[i+1]] = v
+	}
+	return res, true
+}
+
+// splitName 将 InsertSingle_STDLIB_PREPARED/sub-8 拆分为用例 InsertSingle/sub 与 ORM stdlib+prepared
+func splitName(name string) (caseName, ormName string, ok bool) {
+	// 去掉 GOMAXPROCS 后缀
+	if i := strings.LastIndexByte(name, '-'); i > 0 {
+		if _, err := strconv.Atoi(name[i+1:]); err == nil {
+			name = name[:i]
+		}
+	}
+	top, sub, _ := strings.Cut(name, "/")
+	caseName, suffix, ok := strings.Cut(top, "_")
+	if !ok || caseName == "" || suffix == "" {
+		return "", "", false
+	}
+	if sub != "" {
+		caseName += "/" + sub
+	}
+	return caseName, strings.ReplaceAll(strings.ToLower(suffix), "_", "+"), true
+}
+
+// Merge 合并同一用例、同一实现的多次结果（-count > 1），各指标取平均
+func Merge(results []Result) []Result {
+	var merged []Result
+	index := make(map[[2]string]int)
+	counts := make(map[[2]string]int)
+	for _, res := range results {
+		key := [2]string{res.Case, res.ORM}
+		counts[key]++
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			metrics := make(map[string]float64, len(res.Metrics))
+			for unit, v := range res.Metrics {
+				metrics[unit] = v
+			}
+			merged = append(merged, Result{Case: res.Case, ORM: res.ORM, N: res.N, Metrics: metrics})
+			continue
+		}
+		merged[i].N += res.N
+		for unit, v := range res.Metrics {
+			merged[i].Metrics[unit] += v
+		}
+	}
+	for i := range merged {
+		c := float64(counts[[2]string{merged[i].Case, merged[i].ORM}])
+		for unit := range merged[i].Metrics {
+			merged[i].Metrics[unit] /= c
+		}
+	}
+	return merged
+}
+
+// benchName 是 splitName 的逆操作，将用例 InsertSingle 与 ORM stdlib+prepared 还原为 InsertSingle_STDLIB_PREPARED
+func benchName(caseName, ormName string) string {
+	return caseName + "_" + strings.ToUpper(strings.ReplaceAll(ormName, "+", "_"))
+}
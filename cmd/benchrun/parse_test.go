@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	const output = `goos: linux
+goarch: amd64
+pkg: github.com/benchplus/goorm
+BenchmarkInsertSingle_GORM-8              	  100000	     44216 ns/op	    7712 B/op	      98 allocs/op
+BenchmarkInsertSingle_STDLIB_PREPARED     	  186528	      6461 ns/op	     815 B/op	      20 allocs/op
+BenchmarkPostFetch_ZORM/1KB-8             	     200	     11838 ns/op	         2.885 B/payload-B	    2952 B/op	      32 allocs/op
+BenchmarkWideInsert_BUN/cols=8-8          	     200	     11838 ns/op
+--- FAIL: BenchmarkBroken_GORM
+PASS
+ok  	github.com/benchplus/goorm	1.254s
+`
+	results, err := Parse(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		caseName, orm string
+		n             int
+		unit          string
+		value         float64
+	}{
+		{"InsertSingle", "gorm", 100000, "allocs/op", 98},
+		{"InsertSingle", "stdlib+prepared", 186528, "ns/op", 6461},
+		{"PostFetch/1KB", "zorm", 200, "B/payload-B", 2.885},
+		{"WideInsert/cols=8", "bun", 200, "ns/op", 11838},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d: %+v", len(results), len(want), results)
+	}
+	for i, w := range want {
+		got := results[i]
+		if got.Case != w.caseName || got.ORM != w.orm || got.N != w.n || got.Metrics[w.unit] != w.value {
+			t.Errorf("result %d = %+v, want case %s, orm %s, n %d, %s %v", i, got, w.caseName, w.orm, w.n, w.unit, w.value)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	results := Merge([]Result{
+		{Case: "Count", ORM: "gorm", N: 10, Metrics: map[string]float64{"ns/op": 100}},
+		{Case: "Count", ORM: "stdlib", N: 10, Metrics: map[string]float64{"ns/op": 50}},
+		{Case: "Count", ORM: "gorm", N: 30, Metrics: map[string]float64{"ns/op": 300}},
+	})
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if got := results[0]; got.ORM != "gorm" || got.N != 40 || got.Metrics["ns/op"] != 200 {
+		t.Errorf("merged gorm = %+v, want N 40 and 200 ns/op", got)
+	}
+}
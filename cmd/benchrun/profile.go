@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/pprof/profile"
+)
+
+// packageGroup 报告中归并采样的包分组，按顺序匹配函数名前缀
+type packageGroup struct {
+	name     string
+	prefixes []string
+}
+
+// packageGroups 采样按叶子函数所在的包归入分组；未匹配的标准库归入 stdlib，其余归入 other。
+// 生成的 ENT 代码属于 ORM，其他实现的适配代码、模型和统计驱动归入 goorm
+var packageGroups = []packageGroup{
+	{"orm", []string{
+		"gorm.io/", "xorm.io/", "entgo.io/", "ariga.io/",
+		"github.com/IceWhaleTech/zorm", "github.com/orca-zhang/borm",
+		"github.com/jmoiron/sqlx", "github.com/uptrace/bun",
+		"github.com/benchplus/goorm/ent.", "github.com/benchplus/goorm/ent/",
+	}},
+	{"database/sql", []string{"database/sql."}},
+	{"go-sqlite3", []string{"github.com/mattn/go-sqlite3", "_cgo_"}},
+	{"reflect", []string{"reflect.", "internal/reflectlite."}},
+	{"runtime", []string{"runtime.", "internal/runtime/", "gcWriteBarrier"}},
+	{"goorm", []string{"github.com/benchplus/goorm/"}},
+	{"stdlib", nil},
+	{"other", nil},
+}
+
+// Profile 一个基准测试的 CPU 时间和分配字节按包分组的占比
+type Profile struct {
+	Case string
+	ORM  string
+	// CPU 分组到 CPU 采样占比的映射，Alloc 分组到分配字节占比的映射
+	CPU   map[string]float64
+	Alloc map[string]float64
+}
+
+// groupOf 返回函数所属的分组
+func groupOf(function string) string {
+	for _, g := range packageGroups {
+		for _, prefix := range g.prefixes {
+			if strings.HasPrefix(function, prefix) {
+				return g.name
+			}
+		}
+	}
+	pkg := packagePath(function)
+	switch {
+	case pkg == "":
+		// 没有包名的符号来自 cgo 调用的 C 代码，即 SQLite 本身
+		return "go-sqlite3"
+	case !strings.Contains(strings.SplitN(pkg, "/", 2)[0], "."):
+		// 标准库的导入路径第一段不含域名
+		return "stdlib"
+	default:
+		return "other"
+	}
+}
+
+// packagePath 返回函数名中的导入路径，如 encoding/json.(*decodeState).object 返回 encoding/json
+func packagePath(function string) string {
+	// 泛型实例化的类型参数中可能出现其他包的路径
+	if i := strings.IndexByte(function, '['); i >= 0 {
+		function = function[:i]
+	}
+	slash := strings.LastIndexByte(function, '/')
+	dot := strings.IndexByte(function[slash+1:], '.')
+	if dot < 0 {
+		return ""
+	}
+	return function[:slash+1+dot]
+}
+
+// sampleGroup 返回一个采样所属的分组：经 cgo 进入 C 代码的采样归入 go-sqlite3，其余按叶子函数归入
+func sampleGroup(s *profile.Sample) string {
+	leaf := ""
+	for _, loc := range s.Location {
+		for _, line := range loc.Line {
+			if line.Function == nil {
+				continue
+			}
+			name := line.Function.Name
+			if name == "runtime.cgocall" || name == "runtime._ExternalCode" {
+				return "go-sqlite3"
+			}
+			if leaf == "" {
+				leaf = name
+			}
+		}
+	}
+	return groupOf(leaf)
+}
+
+// aggregate 读取 profile，按分组汇总 sampleType 类型的采样值，返回各分组的占比
+func aggregate(path, sampleType string) (map[string]float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := profile.Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	index := -1
+	for i, st := range p.SampleType {
+		if st.Type == sampleType {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("%s: no %s samples", path, sampleType)
+	}
+
+	shares := make(map[string]float64)
+	var total float64
+	for _, s := range p.Sample {
+		v := float64(s.Value[index])
+		shares[sampleGroup(s)] += v
+		total += v
+	}
+	if total > 0 {
+		for g := range shares {
+			shares[g] /= total
+		}
+	}
+	return shares, nil
+}
+
+// runProfiles 为 results 中的每个实现×用例单独运行一次基准测试，把 CPU 和内存分配 profile
+// 写到 dir/<Case>_<ORM>.{cpu,mem}.pprof，返回按包分组的占比。
+// 测试二进制保留为 dir/goorm.test，供 go tool pprof 查看源码和汇编
+func runProfiles(dir, benchtime string, results []Result) ([]Profile, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	bin := filepath.Join(dir, "goorm.test")
+	build := exec.Command("go", "test", "-c", "-o", bin, ".")
+	build.Stdout = os.Stdout
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return nil, err
+	}
+
+	var profiles []Profile
+	seen := make(map[string]bool)
+	for _, res := range results {
+		caseName, _, _ := strings.Cut(res.Case, "/")
+		name := benchName(caseName, res.ORM)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		cpu := filepath.Join(dir, name+".cpu.pprof")
+		mem := filepath.Join(dir, name+".mem.pprof")
+		args := []string{"-test.run", "^$", "-test.bench", "^Benchmark" + name + "$", "-test.benchmem",
+			"-test.cpuprofile", cpu, "-test.memprofile", mem}
+		if benchtime != "" {
+			args = append(args, "-test.benchtime", benchtime)
+		}
+		cmd := exec.Command(bin, args...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("profile %s: %w", name, err)
+		}
+
+		p := Profile{Case: caseName, ORM: res.ORM}
+		var err error
+		if p.CPU, err = aggregate(cpu, "cpu"); err != nil {
+			return nil, err
+		}
+		if p.Alloc, err = aggregate(mem, "alloc_space"); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, p)
+	}
+	fmt.Printf("profiles written to %s\n", dir)
+	return profiles, nil
+}
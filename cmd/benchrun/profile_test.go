@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestGroupOf(t *testing.T) {
+	for function, want := range map[string]string{
+		"gorm.io/gorm/schema.(*Field).setupValuerAndSetter.func1": "orm",
+		"github.com/benchplus/goorm/ent.(*UserQuery).Only":        "orm",
+		"database/sql.(*Rows).Next":                               "database/sql",
+		"github.com/mattn/go-sqlite3.(*SQLiteRows).Next":          "go-sqlite3",
+		"sqlite3VdbeExec":     "go-sqlite3",
+		"reflect.Value.Field": "reflect",
+		"runtime.mallocgc":    "runtime",
+		"github.com/benchplus/goorm/stdlib.(*StdlibORM).GetByID": "goorm",
+		"encoding/json.(*decodeState).object":                    "stdlib",
+		"slices.Sort[go.shape.[]github.com/x/y.T]":               "stdlib",
+		"github.com/goccy/go-json.Unmarshal":                     "other",
+	} {
+		if got := groupOf(function); got != want {
+			t.Errorf("groupOf(%q) = %s, want %s", function, got, want)
+		}
+	}
+}
+
+func TestBenchName(t *testing.T) {
+	for _, name := range []string{"InsertSingle_GORM", "GetByID_STDLIB_PREPARED"} {
+		caseName, ormName, ok := splitName(name)
+		if !ok {
+			t.Fatalf("splitName(%q) failed", name)
+		}
+		if got := benchName(caseName, ormName); got != name {
+			t.Errorf("benchName(%q, %q) = %s, want %s", caseName, ormName, got, name)
+		}
+	}
+}
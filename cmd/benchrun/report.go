@@ -0,0 +1,465 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+// floorORM 参照下限实现，各 ORM 的开销均相对它计算
+const floorORM = "stdlib"
+
+// driverORM 直接调用驱动的实现，stdlib 与它的差值即 database/sql 层的开销
+const driverORM = "direct"
+
+// standardUnits go test -benchmem 输出的标准指标，其余单位视为自定义指标
+var standardUnits = []string{"ns/op", "B/op", "allocs/op"}
+
+// caseResults 一个用例下各实现的结果
+type caseResults struct {
+	name    string
+	results []Result
+}
+
+// groupByCase 按用例分组，保持用例首次出现的顺序，组内按 ns/op 升序
+func groupByCase(results []Result) []caseResults {
+	var cases []caseResults
+	index := make(map[string]int)
+	for _, res := range results {
+		i, ok := index[res.Case]
+		if !ok {
+			i = len(cases)
+			index[res.Case] = i
+			cases = append(cases, caseResults{name: res.Case})
+		}
+		cases[i].results = append(cases[i].results, res)
+	}
+	for _, c := range cases {
+		sort.SliceStable(c.results, func(i, j int) bool {
+			return c.results[i].Metrics["ns/op"] < c.results[j].Metrics["ns/op"]
+		})
+	}
+	return cases
+}
+
+// floor 返回用例中参照实现的结果
+func (c caseResults) floor() (Result, bool) {
+	return c.find(floorORM)
+}
+
+// find 返回用例中指定实现的结果
+func (c caseResults) find(ormName string) (Result, bool) {
+	for _, res := range c.results {
+		if res.ORM == ormName {
+			return res, true
+		}
+	}
+	return Result{}, false
+}
+
+// customUnits 返回用例中出现的自定义指标单位（按字母序）
+func (c caseResults) customUnits() []string {
+	seen := make(map[string]bool)
+	for _, res := range c.results {
+		for unit := range res.Metrics {
+			seen[unit] = true
+		}
+	}
+	for _, unit := range standardUnits {
+		delete(seen, unit)
+	}
+	units := make([]string, 0, len(seen))
+	for unit := range seen {
+		units = append(units, unit)
+	}
+	sort.Strings(units)
+	return units
+}
+
+// LatencyRun 以 sqlite3+latency 驱动在指定往返延迟下运行的结果
+type LatencyRun struct {
+	Latency time.Duration
+	Results []Result
+}
+
+// GCRun 在指定 GC 设置（如 GOGC=50、GOMEMLIMIT=10MiB）下运行的结果
+type GCRun struct {
+	Setting string
+	Results []Result
+}
+
+// Runs 报告的输入。Results 为默认运行的结果，其余为可选的额外运行，可为空
+type Runs struct {
+	Results []Result
+	// Latencies 模拟延迟下的运行
+	Latencies []LatencyRun
+	// Trace sqlite3+trace 驱动下的运行，提供语句数和往返次数
+	Trace []Result
+	// Null null 驱动下的运行
+	Null []Result
+	// Profiles 按包分组的 profile 占比
+	Profiles []Profile
+	// GC 不同 GC 设置下的运行
+	GC []GCRun
+}
+
+// WriteReport 生成 Markdown 报告
+func WriteReport(w io.Writer, runs Runs) error {
+	cases := groupByCase(Merge(runs.Results))
+	var ormNames []string
+	seenORM := make(map[string]bool)
+	for _, c := range cases {
+		for _, res := range c.results {
+			if !seenORM[res.ORM] {
+				seenORM[res.ORM] = true
+				ormNames = append(ormNames, res.ORM)
+			}
+		}
+	}
+	sort.Strings(ormNames)
+
+	var b strings.Builder
+	b.WriteString("# Benchmark Report\n\n")
+	fmt.Fprintf(&b, "Overhead is measured against `%s` (plain `database/sql`, unprepared). "+
+		"Δ is the absolute extra cost per operation, × is the cost relative to `%s`.\n\n", floorORM, floorORM)
+
+	b.WriteString("## Summary (× " + floorORM + ", ns/op)\n\n")
+	writeSummary(&b, cases, ormNames)
+
+	if len(runs.Latencies) > 0 {
+		b.WriteString("\n## Simulated Latency (× " + floorORM + ", ns/op)\n\n")
+		b.WriteString("The same benchmarks run on the `sqlite3+latency` driver, which waits the given time on every " +
+			"round trip (prepare, exec, query, begin, commit, rollback) to approximate a database across the network.\n")
+		for _, run := range runs.Latencies {
+			fmt.Fprintf(&b, "\n### %s per round trip\n\n", run.Latency)
+			writeSummary(&b, groupByCase(Merge(run.Results)), ormNames)
+		}
+	}
+
+	writeGCRuns(&b, cases, runs.GC, ormNames)
+	writeVariants(&b, ormNames)
+	// 计时运行使用不计数的 sqlite3 驱动，语句数取自单独的统计运行；读取已有输出时沿用其中的计数
+	counted := cases
+	if len(runs.Trace) > 0 {
+		counted = groupByCase(Merge(runs.Trace))
+	}
+	writeRoundTrips(&b, counted, ormNames)
+	writeLayers(&b, cases, ormNames)
+	if len(runs.Null) > 0 {
+		writeBreakdown(&b, cases, groupByCase(Merge(runs.Null)))
+	}
+	writeProfiles(&b, runs.Profiles)
+
+	b.WriteString("\n## Details\n")
+	for _, c := range cases {
+		floor, hasFloor := c.floor()
+		units := c.customUnits()
+
+		fmt.Fprintf(&b, "\n### %s\n\n", c.name)
+		b.WriteString("| ORM | ns/op | Δns/op | × | B/op | ΔB/op | allocs/op | Δallocs/op")
+		for _, unit := range units {
+			b.WriteString(" | " + unit)
+		}
+		b.WriteString(" |\n|-----|------:|-------:|--:|-----:|------:|----------:|-----------:")
+		b.WriteString(strings.Repeat("|------:", len(units)))
+		b.WriteString("|\n")
+
+		for _, res := range c.results {
+			b.WriteString("| " + res.ORM)
+			for _, unit := range standardUnits {
+				v, ok := res.Metrics[unit]
+				if !ok {
+					b.WriteString(" | - | -")
+					if unit == "ns/op" {
+						b.WriteString(" | -")
+					}
+					continue
+				}
+				b.WriteString(" | " + formatValue(v))
+				if !hasFloor {
+					b.WriteString(" | -")
+				} else {
+					b.WriteString(" | " + formatDelta(v-floor.Metrics[unit]))
+				}
+				if unit == "ns/op" {
+					if hasFloor {
+						b.WriteString(" | " + formatRatio(v, floor.Metrics[unit]))
+					} else {
+						b.WriteString(" | -")
+					}
+				}
+			}
+			for _, unit := range units {
+				if v, ok := res.Metrics[unit]; ok {
+					b.WriteString(" | " + formatValue(v))
+				} else {
+					b.WriteString(" | -")
+				}
+			}
+			b.WriteString(" |\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeSummary 写出用例 × 实现的 ns/op 表，有参照实现时以相对它的倍数表示
+func writeSummary(b *strings.Builder, cases []caseResults, ormNames []string) {
+	b.WriteString("| Case | " + strings.Join(ormNames, " | ") + " |\n")
+	b.WriteString("|------" + strings.Repeat("|------", len(ormNames)) + "|\n")
+	for _, c := range cases {
+		floor, hasFloor := c.floor()
+		b.WriteString("| " + c.name)
+		for _, name := range ormNames {
+			res, ok := c.find(name)
+			switch {
+			case !ok:
+				b.WriteString(" | -")
+			case !hasFloor:
+				b.WriteString(" | " + formatValue(res.Metrics["ns/op"]))
+			default:
+				b.WriteString(" | " + formatRatio(res.Metrics["ns/op"], floor.Metrics["ns/op"]))
+			}
+		}
+		b.WriteString(" |\n")
+	}
+}
+
+// writeGCRuns 为每个 GC 设置写出各实现相对自身默认运行的 ns/op 倍数，数值越大表示受内存压力影响越大
+func writeGCRuns(b *strings.Builder, cases []caseResults, gcRuns []GCRun, ormNames []string) {
+	if len(gcRuns) == 0 {
+		return
+	}
+	b.WriteString("\n## Memory Pressure (× default run, ns/op)\n\n")
+	b.WriteString("The same benchmarks run under other GC settings. Each cell is the ORM's ns/op under the setting " +
+		"divided by its own ns/op in the default run (GOGC=100, no memory limit), so larger values mean the ORM " +
+		"degrades more under memory pressure. GC cycles, GC CPU share, pause percentiles, peak heap and live goroutines " +
+		"of the default run are in the details (`gc/op`, `gc-cpu-%`, `pause-p50-ns`, `pause-p99-ns`, `peak-heap-B`, `goroutines`).\n")
+	for _, run := range gcRuns {
+		fmt.Fprintf(b, "\n### %s\n\n", run.Setting)
+		b.WriteString("| Case | " + strings.Join(ormNames, " | ") + " |\n")
+		b.WriteString("|------" + strings.Repeat("|------", len(ormNames)) + "|\n")
+		runCases := make(map[string]caseResults)
+		for _, c := range groupByCase(Merge(run.Results)) {
+			runCases[c.name] = c
+		}
+		for _, c := range cases {
+			rc, ok := runCases[c.name]
+			if !ok {
+				continue
+			}
+			b.WriteString("| " + c.name)
+			for _, name := range ormNames {
+				base, hasBase := c.find(name)
+				res, ok := rc.find(name)
+				if !ok || !hasBase {
+					b.WriteString(" | -")
+					continue
+				}
+				b.WriteString(" | " + formatRatio(res.Metrics["ns/op"], base.Metrics["ns/op"]))
+			}
+			b.WriteString(" |\n")
+		}
+	}
+}
+
+// writeVariants 列出结果中出现的配置变体及其与默认配置的区别
+func writeVariants(b *strings.Builder, ormNames []string) {
+	var names []string
+	for _, name := range ormNames {
+		if _, ok := orm.Variants[name]; ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	b.WriteString("\n## Variants\n\n")
+	b.WriteString("Variants are named `<orm>+<variant>` and differ from the default configuration as follows:\n\n")
+	for _, name := range names {
+		fmt.Fprintf(b, "- `%s`: %s\n", name, orm.Variants[name])
+	}
+}
+
+// writeRoundTrips 汇总每次操作的语句数和往返次数，只包含报告了 roundtrips/op 的用例
+func writeRoundTrips(b *strings.Builder, cases []caseResults, ormNames []string) {
+	var counted []caseResults
+	for _, c := range cases {
+		for _, res := range c.results {
+			if _, ok := res.Metrics["roundtrips/op"]; ok {
+				counted = append(counted, c)
+				break
+			}
+		}
+	}
+	if len(counted) == 0 {
+		return
+	}
+
+	b.WriteString("\n## Statements (queries/op / roundtrips/op)\n\n")
+	b.WriteString("Counted in a separate run on the `sqlite3+trace` driver wrapper; the timed runs use the plain " +
+		"`sqlite3` driver, so their ns/op does not include the counting. Queries are executed statements; " +
+		"round trips also include prepare, begin, commit and rollback. " +
+		"The SQL sent for a single operation is in `sql/<Case>_<ORM>.sql`.\n\n")
+	b.WriteString("| Case | " + strings.Join(ormNames, " | ") + " |\n")
+	b.WriteString("|------" + strings.Repeat("|------", len(ormNames)) + "|\n")
+	for _, c := range counted {
+		b.WriteString("| " + c.name)
+		for _, name := range ormNames {
+			res, ok := c.find(name)
+			rt, hasRT := res.Metrics["roundtrips/op"]
+			if !ok || !hasRT {
+				b.WriteString(" | -")
+				continue
+			}
+			b.WriteString(" | " + formatValue(res.Metrics["queries/op"]) + " / " + formatValue(rt))
+		}
+		b.WriteString(" |\n")
+	}
+}
+
+// writeLayers 按层拆分 ns/op：驱动本身（direct）、database/sql（stdlib − direct）
+// 以及各 ORM 在 database/sql 之上的开销（ORM − stdlib）。只包含同时有 direct 和 stdlib 结果的用例。
+func writeLayers(b *strings.Builder, cases []caseResults, ormNames []string) {
+	var layered []caseResults
+	for _, c := range cases {
+		_, hasDriver := c.find(driverORM)
+		_, hasFloor := c.floor()
+		if hasDriver && hasFloor {
+			layered = append(layered, c)
+		}
+	}
+	if len(layered) == 0 {
+		return
+	}
+
+	var orms []string
+	for _, name := range ormNames {
+		if name != driverORM && name != floorORM {
+			orms = append(orms, name)
+		}
+	}
+
+	b.WriteString("\n## Layers (ns/op)\n\n")
+	fmt.Fprintf(b, "`driver` is the cost of `%s` (go-sqlite3 driver interfaces via `sql.Conn.Raw` on the plain `sqlite3` driver), "+
+		"`database/sql` is `%s` − `%s`, and each ORM column is that ORM − `%s`.\n\n", driverORM, floorORM, driverORM, floorORM)
+	b.WriteString("| Case | driver | database/sql | " + strings.Join(orms, " | ") + " |\n")
+	b.WriteString("|------|-------:|-------------:" + strings.Repeat("|------:", len(orms)) + "|\n")
+	for _, c := range layered {
+		driverRes, _ := c.find(driverORM)
+		floor, _ := c.floor()
+		driverNs, floorNs := driverRes.Metrics["ns/op"], floor.Metrics["ns/op"]
+		b.WriteString("| " + c.name + " | " + formatValue(driverNs) + " | " + formatDelta(floorNs-driverNs))
+		for _, name := range orms {
+			if res, ok := c.find(name); ok {
+				b.WriteString(" | " + formatDelta(res.Metrics["ns/op"]-floorNs))
+			} else {
+				b.WriteString(" | -")
+			}
+		}
+		b.WriteString(" |\n")
+	}
+}
+
+// writeBreakdown 用 null 驱动的结果拆分各实现的 ns/op：orm 为 null(ORM) − null(stdlib)，
+// driver 为 null(stdlib)，即 database/sql 与驱动接口的开销，engine 为真实结果 − null(ORM)，即 SQLite 执行 SQL 的时间。
+// 三者之和等于真实结果。只包含 null 运行中有 stdlib 结果的用例
+func writeBreakdown(b *strings.Builder, cases, null []caseResults) {
+	nullCases := make(map[string]caseResults, len(null))
+	for _, c := range null {
+		nullCases[c.name] = c
+	}
+
+	var rows strings.Builder
+	for _, c := range cases {
+		nc, ok := nullCases[c.name]
+		if !ok {
+			continue
+		}
+		nullFloor, ok := nc.floor()
+		if !ok {
+			continue
+		}
+		driverNs := nullFloor.Metrics["ns/op"]
+		for _, res := range c.results {
+			nullRes, ok := nc.find(res.ORM)
+			if !ok {
+				continue
+			}
+			total, nullNs := res.Metrics["ns/op"], nullRes.Metrics["ns/op"]
+			fmt.Fprintf(&rows, "| %s | %s | %s | %s | %s | %s |\n", c.name, res.ORM,
+				formatValue(total), formatValue(nullNs-driverNs), formatValue(driverNs), formatValue(total-nullNs))
+		}
+	}
+	if rows.Len() == 0 {
+		return
+	}
+
+	b.WriteString("\n## Overhead Breakdown (ns/op)\n\n")
+	fmt.Fprintf(b, "The `null` driver answers every statement instantly with canned rows and `LastInsertId` values, "+
+		"so a run on it measures only query building, argument binding and row mapping. "+
+		"`orm` is null(ORM) − null(`%s`), `driver` is null(`%s`) (`database/sql` and driver plumbing), "+
+		"and `engine` is the real result − null(ORM), the time SQLite spends executing. "+
+		"The three add up to `total`; small negative values are measurement noise.\n\n", floorORM, floorORM)
+	b.WriteString("| Case | ORM | total | orm | driver | engine |\n")
+	b.WriteString("|------|-----|------:|----:|-------:|-------:|\n")
+	b.WriteString(rows.String())
+}
+
+// writeProfiles 写出各实现×用例的 CPU 时间和分配字节在各包分组间的占比
+func writeProfiles(b *strings.Builder, profiles []Profile) {
+	if len(profiles) == 0 {
+		return
+	}
+	b.WriteString("\n## Where the Time and Bytes Go (% CPU / % allocated bytes)\n\n")
+	b.WriteString("Each benchmark is re-run alone with `-cpuprofile` and `-memprofile`. A sample counts toward the package " +
+		"of its innermost function, except that time inside cgo calls counts as `go-sqlite3`. " +
+		"`orm` is the ORM library (for ENT including the generated client), `goorm` the adapters, models and driver " +
+		"wrappers of this repository, and `stdlib` the rest of the standard library. Setup before the timer starts is included. " +
+		"The raw profiles are kept as `profiles/<Case>_<ORM>.cpu.pprof` and `.mem.pprof`, " +
+		"e.g. `go tool pprof -top profiles/GetByID_GORM.cpu.pprof`.\n\n")
+	b.WriteString("| Case | ORM")
+	for _, g := range packageGroups {
+		b.WriteString(" | " + g.name)
+	}
+	b.WriteString(" |\n|------|-----" + strings.Repeat("|------:", len(packageGroups)) + "|\n")
+	for _, p := range profiles {
+		b.WriteString("| " + p.Case + " | " + p.ORM)
+		for _, g := range packageGroups {
+			fmt.Fprintf(b, " | %.0f / %.0f", p.CPU[g.name]*100, p.Alloc[g.name]*100)
+		}
+		b.WriteString(" |\n")
+	}
+}
+
+func formatValue(v float64) string {
+	switch abs := math.Abs(v); {
+	case abs >= 100 || v == math.Trunc(v):
+		return fmt.Sprintf("%.0f", v)
+	case abs >= 1:
+		return fmt.Sprintf("%.2f", v)
+	default:
+		return fmt.Sprintf("%.4g", v)
+	}
+}
+
+func formatDelta(d float64) string {
+	if d >= 0 {
+		return "+" + formatValue(d)
+	}
+	return formatValue(d)
+}
+
+func formatRatio(v, floor float64) string {
+	if floor == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2fx", v/floor)
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"runtime/metrics"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// coldStartEnv 子进程中要测量冷启动的实现名，设置时 TestColdStartChild 执行冷启动路径
+const coldStartEnv = "GOORM_COLDSTART"
+
+// coldStartPrefix 子进程输出结果行的前缀
+const coldStartPrefix = "coldstart: "
+
+// coldStart 子进程中一次冷启动的测量结果
+type coldStart struct {
+	// 各阶段耗时：创建实例并打开数据库、建表、首次插入、首次查询
+	Open   time.Duration `json:"open"`
+	Create time.Duration `json:"create"`
+	Insert time.Duration `json:"insert"`
+	Select time.Duration `json:"select"`
+	// Alloc 冷启动路径分配的字节数，Heap 路径结束并 GC 后仍存活的堆增量（schema 缓存、客户端等）
+	Alloc uint64 `json:"alloc"`
+	Heap  uint64 `json:"heap"`
+	// Stats 冷启动路径的驱动调用
+	Stats sqldriver.Stats `json:"stats"`
+}
+
+// BenchmarkColdStart 冷启动测试：在新进程中依次打开数据库、建表、首次插入、首次查询
+func BenchmarkColdStart_GORM(b *testing.B) {
+	benchmarkColdStart(b, "gorm")
+}
+
+func BenchmarkColdStart_XORM(b *testing.B) {
+	benchmarkColdStart(b, "xorm")
+}
+
+func BenchmarkColdStart_ZORM(b *testing.B) {
+	benchmarkColdStart(b, "zorm")
+}
+
+func BenchmarkColdStart_SQLX(b *testing.B) {
+	benchmarkColdStart(b, "sqlx")
+}
+
+func BenchmarkColdStart_BORM(b *testing.B) {
+	benchmarkColdStart(b, "borm")
+}
+
+func BenchmarkColdStart_BUN(b *testing.B) {
+	benchmarkColdStart(b, "bun")
+}
+
+func BenchmarkColdStart_ENT(b *testing.B) {
+	benchmarkColdStart(b, "ent")
+}
+
+func BenchmarkColdStart_STDLIB(b *testing.B) {
+	benchmarkColdStart(b, "stdlib")
+}
+
+func BenchmarkColdStart_DIRECT(b *testing.B) {
+	benchmarkColdStart(b, "direct")
+}
+
+// benchmarkColdStart 每次迭代启动一个测试二进制子进程执行冷启动路径。ns/op 为子进程的墙钟时间，
+// 含进程启动和包初始化；各阶段耗时和堆指标由子进程测量后取平均。
+// 驱动调用和运行时指标都发生在子进程中，不使用 trackBenchmark
+func benchmarkColdStart(b *testing.B, ormName string) {
+	if _, ok := orms[ormName]; !ok {
+		b.Fatalf("unknown ORM: %s", ormName)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		b.Fatal(err)
+	}
+	env := append(os.Environ(), coldStartEnv+"="+ormName)
+
+	var sum coldStart
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cmd := exec.Command(exe, "-test.run", "^TestColdStartChild$", "-test.count", "1")
+		cmd.Env = env
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			b.Fatalf("cold start child failed: %v\n%s", err, out)
+		}
+		res, err := parseColdStart(out)
+		if err != nil {
+			b.Fatal(err)
+		}
+		sum.Open += res.Open
+		sum.Create += res.Create
+		sum.Insert += res.Insert
+		sum.Select += res.Select
+		sum.Alloc += res.Alloc
+		sum.Heap += res.Heap
+		sum.Stats = sum.Stats.Add(res.Stats)
+	}
+	b.StopTimer()
+
+	n := float64(b.N)
+	b.ReportMetric(float64(sum.Open)/n, "open-ns")
+	b.ReportMetric(float64(sum.Create)/n, "create-ns")
+	b.ReportMetric(float64(sum.Insert)/n, "insert-ns")
+	b.ReportMetric(float64(sum.Select)/n, "select-ns")
+	b.ReportMetric(float64(sum.Open+sum.Create+sum.Insert+sum.Select)/n, "cold-ns")
+	b.ReportMetric(float64(sum.Alloc)/n, "alloc-B")
+	b.ReportMetric(float64(sum.Heap)/n, "heap-B")
+	if sqldriver.Counting() {
+		b.ReportMetric(float64(sum.Stats.Queries())/n, "queries/op")
+		b.ReportMetric(float64(sum.Stats.RoundTrips())/n, "roundtrips/op")
+	}
+}
+
+// parseColdStart 从子进程输出中取出结果行
+func parseColdStart(out []byte) (coldStart, error) {
+	var res coldStart
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if line, ok := strings.CutPrefix(scanner.Text(), coldStartPrefix); ok {
+			err := json.Unmarshal([]byte(line), &res)
+			return res, err
+		}
+	}
+	return res, fmt.Errorf("no cold start result in child output:\n%s", out)
+}
+
+// TestColdStartChild 冷启动子进程入口，只在设置了 GOORM_COLDSTART 时运行
+func TestColdStartChild(t *testing.T) {
+	ormName := os.Getenv(coldStartEnv)
+	if ormName == "" {
+		t.Skip(coldStartEnv + " not set; run by BenchmarkColdStart")
+	}
+	ormInfo, ok := orms[ormName]
+	if !ok {
+		t.Fatalf("unknown ORM: %s", ormName)
+	}
+
+	heap := []metrics.Sample{{Name: "/gc/heap/allocs:bytes"}, {Name: metricHeap}}
+	runtime.GC()
+	metrics.Read(heap)
+	allocStart, heapStart := heap[0].Value.Uint64(), heap[1].Value.Uint64()
+	statsStart := sqldriver.Snapshot()
+
+	var res coldStart
+	start := time.Now()
+	o := ormInfo.init()
+	dsn := sqldriver.DSN(ormInfo.dsn())
+	if err := o.Init(dsn); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	res.Open = time.Since(start)
+
+	start = time.Now()
+	if err := o.CreateTable(); err != nil {
+		t.Fatalf("CreateTable failed: %v", err)
+	}
+	res.Create = time.Since(start)
+
+	start = time.Now()
+	user := &models.User{
+		Name:      "cold",
+		Email:     "cold@example.com",
+		Age:       30,
+		CreatedAt: benchTime,
+		UpdatedAt: benchTime,
+	}
+	if err := o.Insert(user); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+	res.Insert = time.Since(start)
+
+	start = time.Now()
+	if _, err := o.GetByID(user.ID); err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	res.Select = time.Since(start)
+
+	res.Stats = sqldriver.Snapshot().Sub(statsStart)
+	// GC 会把各 P 缓存中的分配计数刷新到累计值，之后两个指标都准确
+	runtime.GC()
+	metrics.Read(heap)
+	res.Alloc = heap[0].Value.Uint64() - allocStart
+	if live := heap[1].Value.Uint64(); live > heapStart {
+		res.Heap = live - heapStart
+	}
+	runtime.KeepAlive(o)
+
+	o.DropTable()
+	o.Close()
+	os.Remove(dsn)
+
+	line, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(coldStartPrefix + string(line))
+}
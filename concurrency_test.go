@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// 并发测试的 goroutine 数和每个 goroutine 的迭代次数
+const (
+	concurrentWorkers    = 8
+	concurrentIterations = 25
+)
+
+// concurrentWorker 在共享的实现上交替执行所有操作，只修改自己插入的行，并检查读到的是自己写入的值
+func concurrentWorker(o orm.Interface, worker int) error {
+	for i := 0; i < concurrentIterations; i++ {
+		user := &models.User{
+			Name:      fmt.Sprintf("w%d-%d", worker, i),
+			Email:     fmt.Sprintf("w%d-%d@example.com", worker, i),
+			Age:       1 + worker,
+			CreatedAt: benchTime.Add(time.Duration(worker) * time.Hour),
+			UpdatedAt: benchTime,
+		}
+		if err := o.Insert(user); err != nil {
+			return fmt.Errorf("Insert: %w", err)
+		}
+		batch := make([]*models.User, 3)
+		for j := range batch {
+			batch[j] = &models.User{
+				Name:      fmt.Sprintf("w%d-%d-%d", worker, i, j),
+				Email:     fmt.Sprintf("w%d-%d-%d@example.com", worker, i, j),
+				Age:       1 + worker,
+				CreatedAt: benchTime.Add(time.Duration(worker) * time.Hour),
+				UpdatedAt: benchTime,
+			}
+		}
+		if err := o.InsertBatch(batch); err != nil {
+			return fmt.Errorf("InsertBatch: %w", err)
+		}
+
+		got, err := o.GetByID(user.ID)
+		if err != nil {
+			return fmt.Errorf("GetByID(%d): %w", user.ID, err)
+		}
+		if got.Name != user.Name {
+			return fmt.Errorf("GetByID(%d).Name = %q, want %q", user.ID, got.Name, user.Name)
+		}
+		ids := []int64{user.ID, batch[0].ID, batch[1].ID, batch[2].ID}
+		users, err := o.GetByIDs(ids)
+		if err != nil {
+			return fmt.Errorf("GetByIDs: %w", err)
+		}
+		if len(users) != len(ids) {
+			return fmt.Errorf("GetByIDs(%v) returned %d users, want %d", ids, len(users), len(ids))
+		}
+		for _, u := range users {
+			if u.Age != 1+worker {
+				return fmt.Errorf("GetByIDs(%v) returned user %d of another worker (age %d)", ids, u.ID, u.Age)
+			}
+		}
+
+		user.Name += "-updated"
+		if err := o.Update(user); err != nil {
+			return fmt.Errorf("Update: %w", err)
+		}
+		if got, err := o.GetByID(user.ID); err != nil || got.Name != user.Name {
+			return fmt.Errorf("GetByID(%d) after Update = %v, %v; want name %q", user.ID, got, err, user.Name)
+		}
+		if err := o.Delete(batch[2].ID); err != nil {
+			return fmt.Errorf("Delete: %w", err)
+		}
+
+		if _, err := o.Count(); err != nil {
+			return fmt.Errorf("Count: %w", err)
+		}
+		if _, err := o.GetAll(10, i); err != nil {
+			return fmt.Errorf("GetAll: %w", err)
+		}
+		if _, err := o.RawQuery(rawUserQuery, 10, i); err != nil {
+			return fmt.Errorf("RawQuery: %w", err)
+		}
+		// 每个 goroutine 的 created_at 落在各自的小时内
+		from := benchTime.Add(time.Duration(worker) * time.Hour)
+		ranged, err := o.GetByCreatedRange(from, from.Add(time.Hour))
+		if err != nil {
+			return fmt.Errorf("GetByCreatedRange: %w", err)
+		}
+		if want := 3 * (i + 1); len(ranged) != want {
+			return fmt.Errorf("GetByCreatedRange returned %d users, want %d", len(ranged), want)
+		}
+		if i%5 == 0 {
+			if err := o.Iterate(func(*models.User) error { return nil }); err != nil {
+				return fmt.Errorf("Iterate: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// TestConcurrentUse 检查 orm.Interface 的并发约定：多个 goroutine 共享同一个实例交替执行所有操作。
+// 应在 -race 下运行：go test -race -run TestConcurrentUse
+func TestConcurrentUse(t *testing.T) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		t.Skip("null driver returns canned results")
+	}
+	for _, name := range ormNames() {
+		t.Run(name, func(t *testing.T) {
+			o, cleanup, err := setupORM(name)
+			if err != nil {
+				t.Fatalf("Setup failed: %v", err)
+			}
+			defer cleanup()
+
+			var wg sync.WaitGroup
+			errs := make(chan error, concurrentWorkers)
+			for w := 0; w < concurrentWorkers; w++ {
+				wg.Add(1)
+				go func(worker int) {
+					defer wg.Done()
+					if err := concurrentWorker(o, worker); err != nil {
+						errs <- fmt.Errorf("worker %d: %w", worker, err)
+					}
+				}(w)
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				t.Error(err)
+			}
+
+			count, err := o.Count()
+			if err != nil {
+				t.Fatalf("Count failed: %v", err)
+			}
+			// 每次迭代插入 1+3 行、删除 1 行
+			if want := int64(concurrentWorkers * concurrentIterations * 3); count != want {
+				t.Errorf("Count = %d, want %d", count, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benchplus/goorm/internal/memory"
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// opKind 差分测试中的操作类型
+type opKind byte
+
+const (
+	opInsert opKind = iota
+	opInsertBatch
+	opUpdate
+	opDelete
+	opGetByIDs
+	opGetAll
+	numOpKinds
+)
+
+// maxOps 一个输入最多解码出的操作数
+const maxOps = 64
+
+// op 差分测试中的一个操作，由输入的 3 个字节 (kind, a, c) 解码而来。
+// Update、Delete 和 GetByIDs 的目标按执行时参照实现中已有的 ID 解析，保证命中已有记录
+type op struct {
+	kind opKind
+	a, c byte
+}
+
+// decodeOps 把模糊测试输入解码为操作序列，不足 3 个字节的尾部忽略
+func decodeOps(data []byte) []op {
+	var ops []op
+	for len(data) >= 3 && len(ops) < maxOps {
+		ops = append(ops, op{kind: opKind(data[0] % byte(numOpKinds)), a: data[1], c: data[2]})
+		data = data[3:]
+	}
+	return ops
+}
+
+// encodeOps decodeOps 的逆过程，用于构造种子输入
+func encodeOps(ops ...op) []byte {
+	data := make([]byte, 0, 3*len(ops))
+	for _, o := range ops {
+		data = append(data, byte(o.kind), o.a, o.c)
+	}
+	return data
+}
+
+// fuzzUser 由一个字节生成用户。取值限定在所有实现都接受的范围内（非空名字、正年龄），
+// 各实现校验规则的差异不属于差分测试的范围
+func fuzzUser(v byte) *models.User {
+	return &models.User{
+		Name:      fmt.Sprintf("u%d", v),
+		Email:     fmt.Sprintf("u%d@example.com", v),
+		Age:       1 + int(v)%100,
+		CreatedAt: benchTime.Add(time.Duration(v) * time.Second),
+		UpdatedAt: benchTime.Add(time.Duration(v) * time.Minute),
+	}
+}
+
+// pickID 按序号从参照实现已有的 ID 中选取；序号超出时返回一个不存在的 ID
+func pickID(ids []int64, v byte) int64 {
+	if int(v) < 2*len(ids) {
+		return ids[int(v)%len(ids)]
+	}
+	if len(ids) == 0 {
+		return int64(v) + 1
+	}
+	return ids[len(ids)-1] + int64(v)
+}
+
+// existingIDs 返回参照实现中已有的 ID，升序
+func existingIDs(ref *memory.MemoryORM) []int64 {
+	users, _ := ref.GetAll(-1, 0)
+	ids := make([]int64, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	return ids
+}
+
+// diffUsers 比较两组用户，相同时返回空字符串
+func diffUsers(got, want []*models.User) string {
+	if len(got) != len(want) {
+		return fmt.Sprintf("%d users, want %d (got IDs %v, want %v)", len(got), len(want), userIDs(got), userIDs(want))
+	}
+	for i := range got {
+		g, w := *got[i], *want[i]
+		g.UTC()
+		w.UTC()
+		if !reflect.DeepEqual(g, w) {
+			return fmt.Sprintf("user %d = %+v, want %+v", i, g, w)
+		}
+	}
+	return ""
+}
+
+func userIDs(users []*models.User) []int64 {
+	ids := make([]int64, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	return ids
+}
+
+// sortByID 按 ID 升序排列，用于比较不保证顺序的结果，如 GetByIDs
+func sortByID(users []*models.User) []*models.User {
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+// diverge 在全新的表上对实现和参照实现依次执行 ops，逐个比较返回值，最后比较 Count 和全表内容。
+// 返回执行过的操作记录（目标 ID 已解析）和第一处差异的描述；没有差异时描述为空
+func diverge(ormName string, ops []op) ([]string, string, error) {
+	o, cleanup, err := setupORM(ormName)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cleanup()
+	ref := memory.New()
+	ref.CreateTable()
+
+	var trace []string
+	for _, op := range ops {
+		ids := existingIDs(ref)
+		var step, diff string
+		switch op.kind {
+		case opInsert:
+			got, want := fuzzUser(op.a), fuzzUser(op.a)
+			step = fmt.Sprintf("Insert(%s)", got.Name)
+			err := o.Insert(got)
+			ref.Insert(want)
+			if err != nil {
+				diff = fmt.Sprintf("error %v", err)
+			} else if got.ID != want.ID {
+				diff = fmt.Sprintf("ID %d, want %d", got.ID, want.ID)
+			}
+		case opInsertBatch:
+			n := int(op.a % 4)
+			got, want := make([]*models.User, n), make([]*models.User, n)
+			names := make([]string, n)
+			for i := range got {
+				got[i], want[i] = fuzzUser(op.c+byte(i)), fuzzUser(op.c+byte(i))
+				names[i] = got[i].Name
+			}
+			step = fmt.Sprintf("InsertBatch(%s)", strings.Join(names, ", "))
+			err := o.InsertBatch(got)
+			ref.InsertBatch(want)
+			if err != nil {
+				diff = fmt.Sprintf("error %v", err)
+			} else if g, w := userIDs(got), userIDs(want); !reflect.DeepEqual(g, w) {
+				diff = fmt.Sprintf("IDs %v, want %v", g, w)
+			}
+		case opUpdate:
+			if len(ids) == 0 {
+				continue
+			}
+			got, want := fuzzUser(op.c), fuzzUser(op.c)
+			got.ID = ids[int(op.a)%len(ids)]
+			want.ID = got.ID
+			step = fmt.Sprintf("Update(%d, %s)", got.ID, got.Name)
+			if err := o.Update(got); err != nil {
+				diff = fmt.Sprintf("error %v", err)
+			}
+			ref.Update(want)
+		case opDelete:
+			if len(ids) == 0 {
+				continue
+			}
+			id := ids[int(op.a)%len(ids)]
+			step = fmt.Sprintf("Delete(%d)", id)
+			if err := o.Delete(id); err != nil {
+				diff = fmt.Sprintf("error %v", err)
+			}
+			ref.Delete(id)
+		case opGetByIDs:
+			n := int(op.a%4) + 1
+			query := make([]int64, n)
+			for i := range query {
+				query[i] = pickID(ids, op.c+byte(7*i))
+			}
+			step = fmt.Sprintf("GetByIDs(%v)", query)
+			got, err := o.GetByIDs(query)
+			want, _ := ref.GetByIDs(query)
+			if err != nil {
+				diff = fmt.Sprintf("error %v", err)
+			} else {
+				diff = diffUsers(sortByID(got), want)
+			}
+		case opGetAll:
+			limit, offset := int(op.a%8)+1, int(op.c%8)
+			step = fmt.Sprintf("GetAll(%d, %d)", limit, offset)
+			got, err := o.GetAll(limit, offset)
+			want, _ := ref.GetAll(limit, offset)
+			if err != nil {
+				diff = fmt.Sprintf("error %v", err)
+			} else {
+				diff = diffUsers(got, want)
+			}
+		}
+		trace = append(trace, step)
+		if diff != "" {
+			return trace, step + ": " + diff, nil
+		}
+	}
+
+	gotCount, err := o.Count()
+	wantCount, _ := ref.Count()
+	if err != nil {
+		return trace, fmt.Sprintf("final Count: error %v", err), nil
+	}
+	if gotCount != wantCount {
+		return trace, fmt.Sprintf("final Count = %d, want %d", gotCount, wantCount), nil
+	}
+	got, err := o.GetAll(int(wantCount)+1, 0)
+	want, _ := ref.GetAll(-1, 0)
+	if err != nil {
+		return trace, fmt.Sprintf("final GetAll: error %v", err), nil
+	}
+	if diff := diffUsers(got, want); diff != "" {
+		return trace, "final state: " + diff, nil
+	}
+	return trace, "", nil
+}
+
+// shrink 逐个尝试删除操作，保留仍然产生差异的删除，直到不能再删为止
+func shrink(ops []op, diverges func([]op) bool) []op {
+	for removed := true; removed; {
+		removed = false
+		for i := 0; i < len(ops); i++ {
+			candidate := append(append([]op(nil), ops[:i]...), ops[i+1:]...)
+			if diverges(candidate) {
+				ops = candidate
+				removed = true
+				i--
+			}
+		}
+	}
+	return ops
+}
+
+// checkDifferential 对所有实现执行 ops，发现差异时缩减为最小序列后报告
+func checkDifferential(t *testing.T, ops []op) {
+	for _, name := range ormNames() {
+		_, diff, err := diverge(name, ops)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if diff == "" {
+			continue
+		}
+		minimal := shrink(ops, func(ops []op) bool {
+			_, diff, err := diverge(name, ops)
+			return err == nil && diff != ""
+		})
+		trace, diff, _ := diverge(name, minimal)
+		t.Errorf("%s diverges from the reference model: %s\nminimal sequence:\n\t%s", name, diff, strings.Join(trace, "\n\t"))
+	}
+}
+
+// FuzzDifferential 随机操作序列的差分测试：每个实现与内存参照实现的可观察状态必须一致。
+// go test 只运行种子输入；go test -run '^$' -fuzz FuzzDifferential 持续生成新序列
+func FuzzDifferential(f *testing.F) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		f.Skip("null driver returns canned results")
+	}
+	f.Add(encodeOps(op{opInsert, 1, 0}, op{opGetAll, 7, 0}))
+	f.Add(encodeOps(op{opInsertBatch, 3, 10}, op{opGetByIDs, 3, 0}))
+	f.Add(encodeOps(op{opInsertBatch, 3, 10}, op{opInsert, 4, 0}, op{opDelete, 1, 0}, op{opUpdate, 0, 20}, op{opGetAll, 7, 0}))
+	f.Add(encodeOps(op{opInsert, 1, 0}, op{opInsert, 2, 0}, op{opDelete, 1, 0}, op{opInsert, 3, 0}, op{opGetByIDs, 3, 200}))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		checkDifferential(t, decodeOps(data))
+	})
+}
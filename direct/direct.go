@@ -0,0 +1,352 @@
+// Package direct 绕过 database/sql，直接调用 go-sqlite3 驱动接口的实现，作为理论下限。
+//
+// 通过 sql.Conn.Raw 取得底层驱动连接（默认驱动下即 *sqlite3.SQLiteConn，没有统计用的包装层），
+// 所有操作都在固定的一条连接上、以预编译的 driver.Stmt 执行，
+// 参数直接构造为 driver.NamedValue，结果按 driver.Value 读取，省去了连接池、Scan 反射和 driver.Value 转换。
+package direct
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+type DirectORM struct {
+	db   *sql.DB
+	conn *sql.Conn
+	ctx  context.Context
+
+	// stmts 预编译语句缓存，只在 withConn 内访问，由连接锁保护
+	stmts map[string]driver.Stmt
+}
+
+func New() *DirectORM {
+	return &DirectORM{ctx: context.Background(), stmts: make(map[string]driver.Stmt)}
+}
+
+func (d *DirectORM) Init(dsn string) error {
+	var err error
+	d.db, err = sql.Open(sqldriver.Name(), dsn)
+	if err != nil {
+		return err
+	}
+	d.conn, err = d.db.Conn(d.ctx)
+	if err != nil {
+		d.db.Close()
+		return err
+	}
+	return nil
+}
+
+func (d *DirectORM) Close() error {
+	d.withConn(func(driverConn) error {
+		d.closeStmts()
+		return nil
+	})
+	d.conn.Close()
+	return d.db.Close()
+}
+
+// driverConn 直接使用的驱动连接接口。默认为 *sqlite3.SQLiteConn；
+// GOORM_DRIVER 选择统计、延迟或 null 驱动时为 sqldriver 的包装连接，与其他实现一致
+type driverConn interface {
+	driver.Conn
+	driver.ExecerContext
+}
+
+// withConn 在固定的底层驱动连接上执行 fn。
+// fn 执行期间持有连接锁，不能在其中再调用 DirectORM 的方法。
+func (d *DirectORM) withConn(fn func(c driverConn) error) error {
+	return d.conn.Raw(func(dc interface{}) error {
+		return fn(dc.(driverConn))
+	})
+}
+
+// stmt 返回 query 对应的预编译语句，首次使用时编译并缓存（须在 withConn 内调用）
+func (d *DirectORM) stmt(c driverConn, query string) (driver.Stmt, error) {
+	if stmt, ok := d.stmts[query]; ok {
+		return stmt, nil
+	}
+	stmt, err := c.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	d.stmts[query] = stmt
+	return stmt, nil
+}
+
+// closeStmts 关闭所有缓存的语句，DROP TABLE 前调用以免表被未结束的语句锁住
+func (d *DirectORM) closeStmts() {
+	for query, stmt := range d.stmts {
+		stmt.Close()
+		delete(d.stmts, query)
+	}
+}
+
+// named 将按位置排列的参数包装为 driver.NamedValue
+func named(args ...driver.Value) []driver.NamedValue {
+	values := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		values[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return values
+}
+
+func (d *DirectORM) exec(query string, args []driver.NamedValue) (driver.Result, error) {
+	var result driver.Result
+	err := d.withConn(func(c driverConn) error {
+		stmt, err := d.stmt(c, query)
+		if err != nil {
+			return err
+		}
+		result, err = stmt.(driver.StmtExecContext).ExecContext(d.ctx, args)
+		return err
+	})
+	return result, err
+}
+
+// query 执行查询，对每一行调用 fn；row 在下一次调用时会被覆盖
+func (d *DirectORM) query(query string, args []driver.NamedValue, fn func(row []driver.Value) error) error {
+	return d.withConn(func(c driverConn) error {
+		stmt, err := d.stmt(c, query)
+		if err != nil {
+			return err
+		}
+		rows, err := stmt.(driver.StmtQueryContext).QueryContext(d.ctx, args)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		row := make([]driver.Value, len(rows.Columns()))
+		for {
+			if err := rows.Next(row); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// ddl 直接在连接上执行不带参数的语句
+func (d *DirectORM) ddl(statements ...string) error {
+	return d.withConn(func(c driverConn) error {
+		d.closeStmts()
+		for _, s := range statements {
+			if _, err := c.ExecContext(d.ctx, s, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *DirectORM) CreateTable() error {
+	return d.ddl(`
+		CREATE TABLE IF NOT EXISTS users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at)`)
+}
+
+func (d *DirectORM) DropTable() error {
+	return d.ddl("DROP TABLE IF EXISTS users")
+}
+
+const userColumns = "id, name, email, age, created_at, updated_at"
+
+// toUser 将一行 driver.Value 转换为 User，列顺序与 userColumns 一致
+func toUser(row []driver.Value) (*models.User, error) {
+	id, ok1 := row[0].(int64)
+	name, ok2 := row[1].(string)
+	email, ok3 := row[2].(string)
+	age, ok4 := row[3].(int64)
+	createdAt, ok5 := row[4].(time.Time)
+	updatedAt, ok6 := row[5].(time.Time)
+	if !ok1 || !ok2 || !ok3 || !ok4 || !ok5 || !ok6 {
+		return nil, fmt.Errorf("unexpected user row types: %T %T %T %T %T %T", row[0], row[1], row[2], row[3], row[4], row[5])
+	}
+	return &models.User{
+		ID:        id,
+		Name:      name,
+		Email:     email,
+		Age:       int(age),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+func (d *DirectORM) queryUsers(query string, capacity int, args []driver.NamedValue) ([]*models.User, error) {
+	users := make([]*models.User, 0, capacity)
+	err := d.query(query, args, func(row []driver.Value) error {
+		user, err := toUser(row)
+		if err != nil {
+			return err
+		}
+		users = append(users, user)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (d *DirectORM) Insert(user *models.User) error {
+	result, err := d.exec(`INSERT INTO users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		named(user.Name, user.Email, int64(user.Age), user.CreatedAt.UTC(), user.UpdatedAt.UTC()))
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+func (d *DirectORM) InsertBatch(users []*models.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	// 单条多行 INSERT
+	var query strings.Builder
+	query.WriteString(`INSERT INTO users (name, email, age, created_at, updated_at) VALUES `)
+	args := make([]driver.NamedValue, 0, len(users)*5)
+	for i, user := range users {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?)")
+		n := len(args)
+		args = append(args,
+			driver.NamedValue{Ordinal: n + 1, Value: user.Name},
+			driver.NamedValue{Ordinal: n + 2, Value: user.Email},
+			driver.NamedValue{Ordinal: n + 3, Value: int64(user.Age)},
+			driver.NamedValue{Ordinal: n + 4, Value: user.CreatedAt.UTC()},
+			driver.NamedValue{Ordinal: n + 5, Value: user.UpdatedAt.UTC()},
+		)
+	}
+
+	result, err := d.exec(query.String(), args)
+	if err != nil {
+		return err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	// 多行插入时 last_insert_rowid 为最后一行的 ID，单语句内自增 ID 连续
+	firstID := lastID - int64(len(users)) + 1
+	for i, user := range users {
+		user.ID = firstID + int64(i)
+	}
+	return nil
+}
+
+func (d *DirectORM) GetByID(id int64) (*models.User, error) {
+	users, err := d.queryUsers("SELECT "+userColumns+" FROM users WHERE id = ?", 1, named(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user not found")
+	}
+	return users[0], nil
+}
+
+func (d *DirectORM) GetByIDs(ids []int64) ([]*models.User, error) {
+	if len(ids) == 0 {
+		return []*models.User{}, nil
+	}
+
+	args := make([]driver.NamedValue, len(ids))
+	for i, id := range ids {
+		args[i] = driver.NamedValue{Ordinal: i + 1, Value: id}
+	}
+	query := "SELECT " + userColumns + " FROM users WHERE id IN (?" + strings.Repeat(", ?", len(ids)-1) + ")"
+	return d.queryUsers(query, len(ids), args)
+}
+
+func (d *DirectORM) Update(user *models.User) error {
+	_, err := d.exec(`UPDATE users SET name = ?, email = ?, age = ?, created_at = ?, updated_at = ? WHERE id = ?`,
+		named(user.Name, user.Email, int64(user.Age), user.CreatedAt.UTC(), user.UpdatedAt.UTC(), user.ID))
+	return err
+}
+
+func (d *DirectORM) Delete(id int64) error {
+	_, err := d.exec("DELETE FROM users WHERE id = ?", named(id))
+	return err
+}
+
+func (d *DirectORM) Count() (int64, error) {
+	var count int64
+	err := d.query("SELECT COUNT(*) FROM users", nil, func(row []driver.Value) error {
+		count = row[0].(int64)
+		return nil
+	})
+	return count, err
+}
+
+func (d *DirectORM) GetAll(limit, offset int) ([]*models.User, error) {
+	return d.queryUsers("SELECT "+userColumns+" FROM users ORDER BY id LIMIT ? OFFSET ?", limit, named(int64(limit), int64(offset)))
+}
+
+func (d *DirectORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	return d.queryUsers("SELECT "+userColumns+" FROM users WHERE created_at >= ? AND created_at < ? ORDER BY created_at", 0,
+		named(from.UTC(), to.UTC()))
+}
+
+func (d *DirectORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	values := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		v, err := driver.DefaultParameterConverter.ConvertValue(arg)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+	}
+	return d.queryUsers(query, 0, values)
+}
+
+// Iterate 逐行回调 fn；fn 在持有连接锁时执行，不能在其中再调用 DirectORM 的方法
+func (d *DirectORM) Iterate(fn func(*models.User) error) error {
+	return d.query("SELECT "+userColumns+" FROM users", nil, func(row []driver.Value) error {
+		user, err := toUser(row)
+		if err != nil {
+			return err
+		}
+		return fn(user)
+	})
+}
+
+// GetDSN 生成测试用的 DSN
+func GetDSN() string {
+	return fmt.Sprintf("file:%s?cache=shared&mode=memory", getTempFile())
+}
+
+func getTempFile() string {
+	tmpfile, _ := os.CreateTemp("", "direct_*.db")
+	tmpfile.Close()
+	return tmpfile.Name()
+}
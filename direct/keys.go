@@ -0,0 +1,160 @@
+package direct
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
+
+func (d *DirectORM) CreateKeyTables() error {
+	return d.ddl(`
+		CREATE TABLE IF NOT EXISTS uuid_users (
+			id VARCHAR(36) PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_uuid_users_created_at ON uuid_users (created_at)`, `
+		CREATE TABLE IF NOT EXISTS snowflake_users (
+			id INTEGER PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_snowflake_users_created_at ON snowflake_users (created_at)`)
+}
+
+func (d *DirectORM) DropKeyTables() error {
+	return d.ddl("DROP TABLE IF EXISTS uuid_users", "DROP TABLE IF EXISTS snowflake_users")
+}
+
+// keyValues 返回一行的插入参数，UUID 以文本绑定
+func keyValues(id driver.Value, name, email string, age int, createdAt, updatedAt time.Time) []driver.Value {
+	return []driver.Value{id, name, email, int64(age), createdAt.UTC(), updatedAt.UTC()}
+}
+
+// insertKeyRows 以单条多行 INSERT 写入 rows，主键由客户端生成，无需读取 LastInsertId
+func (d *DirectORM) insertKeyRows(table string, rows [][]driver.Value) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	var query strings.Builder
+	query.WriteString("INSERT INTO " + table + " (id, name, email, age, created_at, updated_at) VALUES ")
+	args := make([]driver.NamedValue, 0, len(rows)*6)
+	for i, row := range rows {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?, ?)")
+		for _, v := range row {
+			args = append(args, driver.NamedValue{Ordinal: len(args) + 1, Value: v})
+		}
+	}
+	_, err := d.exec(query.String(), args)
+	return err
+}
+
+// queryKeyRow 按主键查询一行，scan 接收列顺序与 userColumns 一致的值；无结果时返回错误
+func (d *DirectORM) queryKeyRow(table string, id driver.Value, scan func(row []driver.Value) error) error {
+	found := false
+	err := d.query("SELECT "+userColumns+" FROM "+table+" WHERE id = ?", named(id), func(row []driver.Value) error {
+		found = true
+		return scan(row)
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("user not found")
+	}
+	return nil
+}
+
+// toKeyColumns 转换 id 以外的列，列顺序与 userColumns 一致
+func toKeyColumns(row []driver.Value) (name, email string, age int64, createdAt, updatedAt time.Time, err error) {
+	name, ok1 := row[1].(string)
+	email, ok2 := row[2].(string)
+	age, ok3 := row[3].(int64)
+	createdAt, ok4 := row[4].(time.Time)
+	updatedAt, ok5 := row[5].(time.Time)
+	if !ok1 || !ok2 || !ok3 || !ok4 || !ok5 {
+		err = fmt.Errorf("unexpected user row types: %T %T %T %T %T %T", row[0], row[1], row[2], row[3], row[4], row[5])
+	}
+	return
+}
+
+func (d *DirectORM) InsertUUIDUser(user *models.UUIDUser) error {
+	return d.InsertUUIDBatch([]*models.UUIDUser{user})
+}
+
+func (d *DirectORM) InsertUUIDBatch(users []*models.UUIDUser) error {
+	rows := make([][]driver.Value, len(users))
+	for i, user := range users {
+		rows[i] = keyValues(user.ID.String(), user.Name, user.Email, user.Age, user.CreatedAt, user.UpdatedAt)
+	}
+	return d.insertKeyRows("uuid_users", rows)
+}
+
+func (d *DirectORM) GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error) {
+	var user *models.UUIDUser
+	err := d.queryKeyRow("uuid_users", id.String(), func(row []driver.Value) error {
+		s, ok := row[0].(string)
+		if !ok {
+			return fmt.Errorf("unexpected id type %T", row[0])
+		}
+		parsed, err := uuid.Parse(s)
+		if err != nil {
+			return err
+		}
+		name, email, age, createdAt, updatedAt, err := toKeyColumns(row)
+		if err != nil {
+			return err
+		}
+		user = &models.UUIDUser{ID: parsed, Name: name, Email: email, Age: int(age), CreatedAt: createdAt, UpdatedAt: updatedAt}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (d *DirectORM) InsertSnowflakeUser(user *models.SnowflakeUser) error {
+	return d.InsertSnowflakeBatch([]*models.SnowflakeUser{user})
+}
+
+func (d *DirectORM) InsertSnowflakeBatch(users []*models.SnowflakeUser) error {
+	rows := make([][]driver.Value, len(users))
+	for i, user := range users {
+		rows[i] = keyValues(user.ID, user.Name, user.Email, user.Age, user.CreatedAt, user.UpdatedAt)
+	}
+	return d.insertKeyRows("snowflake_users", rows)
+}
+
+func (d *DirectORM) GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error) {
+	var user *models.SnowflakeUser
+	err := d.queryKeyRow("snowflake_users", id, func(row []driver.Value) error {
+		id, ok := row[0].(int64)
+		if !ok {
+			return fmt.Errorf("unexpected id type %T", row[0])
+		}
+		name, email, age, createdAt, updatedAt, err := toKeyColumns(row)
+		if err != nil {
+			return err
+		}
+		user = &models.SnowflakeUser{ID: id, Name: name, Email: email, Age: int(age), CreatedAt: createdAt, UpdatedAt: updatedAt}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
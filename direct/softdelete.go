@@ -0,0 +1,77 @@
+package direct
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (d *DirectORM) CreateSoftUserTable() error {
+	if err := d.ddl(`
+		CREATE TABLE IF NOT EXISTS soft_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME
+		)
+	`); err != nil {
+		return err
+	}
+	if err := d.ddl(`CREATE INDEX IF NOT EXISTS idx_soft_users_created_at ON soft_users (created_at)`); err != nil {
+		return err
+	}
+	return d.ddl(`CREATE INDEX IF NOT EXISTS idx_soft_users_deleted_at ON soft_users (deleted_at)`)
+}
+
+func (d *DirectORM) DropSoftUserTable() error {
+	return d.ddl("DROP TABLE IF EXISTS soft_users")
+}
+
+func (d *DirectORM) InsertSoftUser(user *models.User) error {
+	result, err := d.exec(`INSERT INTO soft_users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		named(user.Name, user.Email, int64(user.Age), user.CreatedAt.UTC(), user.UpdatedAt.UTC()))
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+// DeleteSoftUser 已删除的记录不再更新，保留第一次删除的时间
+func (d *DirectORM) DeleteSoftUser(id int64) error {
+	_, err := d.exec("UPDATE soft_users SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL", named(time.Now().UTC(), id))
+	return err
+}
+
+func (d *DirectORM) GetSoftUserByID(id int64) (*models.User, error) {
+	users, err := d.queryUsers("SELECT "+userColumns+" FROM soft_users WHERE id = ? AND deleted_at IS NULL", 1, named(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user not found")
+	}
+	return users[0], nil
+}
+
+func (d *DirectORM) CountSoftUsers() (int64, error) {
+	var count int64
+	err := d.query("SELECT COUNT(*) FROM soft_users WHERE deleted_at IS NULL", nil, func(row []driver.Value) error {
+		count = row[0].(int64)
+		return nil
+	})
+	return count, err
+}
+
+func (d *DirectORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	return d.queryUsers("SELECT "+userColumns+" FROM soft_users WHERE deleted_at IS NULL ORDER BY id LIMIT ? OFFSET ?", limit, named(int64(limit), int64(offset)))
+}
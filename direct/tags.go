@@ -0,0 +1,124 @@
+package direct
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// usersWithTagsQuery 分页查询用户并左连接其标签，每个用户的行相邻，没有标签的用户只有一行且标签列为 NULL
+const usersWithTagsQuery = `
+	SELECT u.id, u.name, u.email, u.age, u.created_at, u.updated_at, t.id, t.name
+	FROM (SELECT ` + userColumns + ` FROM users ORDER BY id LIMIT ? OFFSET ?) AS u
+	LEFT JOIN user_tags AS ut ON ut.user_id = u.id
+	LEFT JOIN tags AS t ON t.id = ut.tag_id
+	ORDER BY u.id, t.id
+`
+
+func (d *DirectORM) CreateTagTables() error {
+	return d.ddl(`
+		CREATE TABLE IF NOT EXISTS tags (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL UNIQUE
+		)
+	`, `
+		CREATE TABLE IF NOT EXISTS user_tags (
+			user_id INTEGER NOT NULL,
+			tag_id INTEGER NOT NULL,
+			PRIMARY KEY (user_id, tag_id)
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_user_tags_tag_id ON user_tags (tag_id)`)
+}
+
+func (d *DirectORM) DropTagTables() error {
+	return d.ddl("DROP TABLE IF EXISTS user_tags", "DROP TABLE IF EXISTS tags")
+}
+
+func (d *DirectORM) InsertTags(tags []*models.Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	// 单条多行 INSERT
+	args := make([]driver.NamedValue, len(tags))
+	for i, tag := range tags {
+		args[i] = driver.NamedValue{Ordinal: i + 1, Value: tag.Name}
+	}
+	result, err := d.exec("INSERT INTO tags (name) VALUES (?)"+strings.Repeat(", (?)", len(tags)-1), args)
+	if err != nil {
+		return err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	// 多行插入时 last_insert_rowid 为最后一行的 ID，单语句内自增 ID 连续
+	firstID := lastID - int64(len(tags)) + 1
+	for i, tag := range tags {
+		tag.ID = firstID + int64(i)
+	}
+	return nil
+}
+
+func (d *DirectORM) AttachTags(userID int64, tagIDs []int64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	args := make([]driver.NamedValue, 0, len(tagIDs)*2)
+	for _, tagID := range tagIDs {
+		n := len(args)
+		args = append(args,
+			driver.NamedValue{Ordinal: n + 1, Value: userID},
+			driver.NamedValue{Ordinal: n + 2, Value: tagID},
+		)
+	}
+	_, err := d.exec("INSERT INTO user_tags (user_id, tag_id) VALUES (?, ?)"+strings.Repeat(", (?, ?)", len(tagIDs)-1)+" ON CONFLICT DO NOTHING", args)
+	return err
+}
+
+func (d *DirectORM) GetUsersWithTags(limit, offset int) ([]*models.TaggedUser, error) {
+	users := make([]*models.TaggedUser, 0, limit)
+	err := d.query(usersWithTagsQuery, named(int64(limit), int64(offset)), func(row []driver.Value) error {
+		id, ok := row[0].(int64)
+		if !ok {
+			return fmt.Errorf("unexpected user id type %T", row[0])
+		}
+		if len(users) == 0 || users[len(users)-1].ID != id {
+			user, err := toUser(row)
+			if err != nil {
+				return err
+			}
+			users = append(users, &models.TaggedUser{User: *user})
+		}
+		// 没有标签的用户标签列为 NULL
+		if row[6] == nil {
+			return nil
+		}
+		tagID, ok1 := row[6].(int64)
+		name, ok2 := row[7].(string)
+		if !ok1 || !ok2 {
+			return fmt.Errorf("unexpected tag row types: %T %T", row[6], row[7])
+		}
+		last := users[len(users)-1]
+		last.Tags = append(last.Tags, &models.Tag{ID: tagID, Name: name})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (d *DirectORM) GetUsersByTag(name string) ([]*models.User, error) {
+	return d.queryUsers(`
+		SELECT users.id, users.name, users.email, users.age, users.created_at, users.updated_at
+		FROM users
+		JOIN user_tags ON user_tags.user_id = users.id
+		JOIN tags ON tags.id = user_tags.tag_id
+		WHERE tags.name = ?
+		ORDER BY users.id
+	`, 0, named(name))
+}
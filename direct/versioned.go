@@ -0,0 +1,80 @@
+package direct
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+func (d *DirectORM) CreateVersionedTable() error {
+	return d.ddl(`
+		CREATE TABLE IF NOT EXISTS versioned_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			version INTEGER NOT NULL
+		)
+	`)
+}
+
+func (d *DirectORM) DropVersionedTable() error {
+	return d.ddl("DROP TABLE IF EXISTS versioned_users")
+}
+
+func (d *DirectORM) InsertVersioned(user *models.VersionedUser) error {
+	result, err := d.exec(`INSERT INTO versioned_users (name, email, age, version) VALUES (?, ?, ?, 1)`,
+		named(user.Name, user.Email, int64(user.Age)))
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	user.Version = 1
+	return nil
+}
+
+func (d *DirectORM) UpdateIfVersion(user *models.VersionedUser, expectedVersion int64) error {
+	result, err := d.exec(`UPDATE versioned_users SET name = ?, email = ?, age = ?, version = version + 1 WHERE id = ? AND version = ?`,
+		named(user.Name, user.Email, int64(user.Age), user.ID, expectedVersion))
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return orm.ErrVersionConflict
+	}
+	user.Version = expectedVersion + 1
+	return nil
+}
+
+func (d *DirectORM) GetVersionedByID(id int64) (*models.VersionedUser, error) {
+	var user *models.VersionedUser
+	err := d.query("SELECT id, name, email, age, version FROM versioned_users WHERE id = ?", named(id), func(row []driver.Value) error {
+		id, ok1 := row[0].(int64)
+		name, ok2 := row[1].(string)
+		email, ok3 := row[2].(string)
+		age, ok4 := row[3].(int64)
+		version, ok5 := row[4].(int64)
+		if !ok1 || !ok2 || !ok3 || !ok4 || !ok5 {
+			return fmt.Errorf("unexpected versioned user row types: %T %T %T %T %T", row[0], row[1], row[2], row[3], row[4])
+		}
+		user = &models.VersionedUser{ID: id, Name: name, Email: email, Age: int(age), Version: version}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	return user, nil
+}
@@ -0,0 +1,65 @@
+package ent
+
+import (
+	"github.com/benchplus/goorm/ent/schema"
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (e *EntORM) CreateHookTable() error {
+	// Schema.Create 已包含 hook_users 表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropHookTable() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.HookUser.Delete().Exec(e.ctx)
+	return nil
+}
+
+func (e *EntORM) InsertHooked(userModel *models.User) error {
+	u, err := e.client.HookUser.
+		Create().
+		SetName(userModel.Name).
+		SetEmail(userModel.Email).
+		SetAge(userModel.Age).
+		SetCreatedAt(userModel.CreatedAt.UTC()).
+		SetUpdatedAt(userModel.UpdatedAt.UTC()).
+		Save(e.ctx)
+	if err != nil {
+		return err
+	}
+	userModel.ID = u.ID
+	return nil
+}
+
+func (e *EntORM) UpdateHooked(userModel *models.User) error {
+	_, err := e.client.HookUser.
+		UpdateOneID(userModel.ID).
+		SetName(userModel.Name).
+		SetEmail(userModel.Email).
+		SetAge(userModel.Age).
+		SetCreatedAt(userModel.CreatedAt.UTC()).
+		SetUpdatedAt(userModel.UpdatedAt.UTC()).
+		Save(e.ctx)
+	return err
+}
+
+func (e *EntORM) GetHookedByID(id int64) (*models.User, error) {
+	u, err := e.client.HookUser.Get(e.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.User{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Age:       u.Age,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}, nil
+}
+
+// HookCalls 钩子和拦截器定义在 schema 中，计数由 schema.HookCalls 保存，所有客户端共享
+func (e *EntORM) HookCalls() int64 {
+	return schema.HookCalls.Load()
+}
@@ -0,0 +1,103 @@
+package ent
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
+
+func (e *EntORM) CreateKeyTables() error {
+	// Schema.Create 已包含 uuid_users 和 snowflake_users 表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropKeyTables() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.UUIDUser.Delete().Exec(e.ctx)
+	_, _ = e.client.SnowflakeUser.Delete().Exec(e.ctx)
+	return nil
+}
+
+// uuidUserCreate 设置调用方生成的 ID；不设置时由 schema 中的 Default(uuid.New) 生成
+func (e *EntORM) uuidUserCreate(u *models.UUIDUser) *UUIDUserCreate {
+	return e.client.UUIDUser.
+		Create().
+		SetID(u.ID).
+		SetName(u.Name).
+		SetEmail(u.Email).
+		SetAge(u.Age).
+		SetCreatedAt(u.CreatedAt.UTC()).
+		SetUpdatedAt(u.UpdatedAt.UTC())
+}
+
+func (e *EntORM) InsertUUIDUser(userModel *models.UUIDUser) error {
+	_, err := e.uuidUserCreate(userModel).Save(e.ctx)
+	return err
+}
+
+func (e *EntORM) InsertUUIDBatch(users []*models.UUIDUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	builders := make([]*UUIDUserCreate, len(users))
+	for i, u := range users {
+		builders[i] = e.uuidUserCreate(u)
+	}
+	return e.client.UUIDUser.CreateBulk(builders...).Exec(e.ctx)
+}
+
+func (e *EntORM) GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error) {
+	u, err := e.client.UUIDUser.Get(e.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.UUIDUser{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Age:       u.Age,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}, nil
+}
+
+func (e *EntORM) snowflakeUserCreate(u *models.SnowflakeUser) *SnowflakeUserCreate {
+	return e.client.SnowflakeUser.
+		Create().
+		SetID(u.ID).
+		SetName(u.Name).
+		SetEmail(u.Email).
+		SetAge(u.Age).
+		SetCreatedAt(u.CreatedAt.UTC()).
+		SetUpdatedAt(u.UpdatedAt.UTC())
+}
+
+func (e *EntORM) InsertSnowflakeUser(userModel *models.SnowflakeUser) error {
+	_, err := e.snowflakeUserCreate(userModel).Save(e.ctx)
+	return err
+}
+
+func (e *EntORM) InsertSnowflakeBatch(users []*models.SnowflakeUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	builders := make([]*SnowflakeUserCreate, len(users))
+	for i, u := range users {
+		builders[i] = e.snowflakeUserCreate(u)
+	}
+	return e.client.SnowflakeUser.CreateBulk(builders...).Exec(e.ctx)
+}
+
+func (e *EntORM) GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error) {
+	u, err := e.client.SnowflakeUser.Get(e.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SnowflakeUser{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Age:       u.Age,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}, nil
+}
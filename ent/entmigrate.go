@@ -0,0 +1,60 @@
+package ent
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// migrateTable 按步骤构造 migrate_users 的 ENT 表定义。迁移前后的结构无法同时作为生成的实体存在，
+// 这里手写与 ent/migrate/schema.go 相同形式的定义，交给 Schema.Create 所用的迁移器比对
+func migrateTable(step *models.MigrateStep) *schema.Table {
+	nameSize := int64(50)
+	if step != nil && *step == models.MigrateWidenColumn {
+		nameSize = 200
+	}
+	columns := []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: nameSize},
+		{Name: "email", Type: field.TypeString, Size: 100},
+		{Name: "age", Type: field.TypeInt},
+	}
+	if step != nil && *step == models.MigrateAddColumn {
+		columns = append(columns, &schema.Column{Name: "nickname", Type: field.TypeString, Nullable: true, Size: 50})
+	}
+	table := &schema.Table{
+		Name:       models.MigrateTable,
+		Columns:    columns,
+		PrimaryKey: []*schema.Column{columns[0]},
+	}
+	if step != nil && *step == models.MigrateAddIndex {
+		table.Indexes = []*schema.Index{{Name: "idx_migrate_users_email", Columns: []*schema.Column{columns[2]}}}
+	}
+	return table
+}
+
+func (e *EntORM) migrateTo(step *models.MigrateStep) error {
+	m, err := schema.NewMigrate(e.drv)
+	if err != nil {
+		return err
+	}
+	return m.Create(e.ctx, migrateTable(step))
+}
+
+func (e *EntORM) CreateMigrateTable() error {
+	return e.migrateTo(nil)
+}
+
+func (e *EntORM) DropMigrateTable() error {
+	return e.drv.Exec(e.ctx, "DROP TABLE IF EXISTS "+models.MigrateTable, []any{}, nil)
+}
+
+// Migrate 以目标结构调用迁移器，由 Atlas 比对现有表结构后生成变更
+func (e *EntORM) Migrate(step models.MigrateStep) error {
+	if step < models.MigrateAddColumn || step > models.MigrateWidenColumn {
+		return fmt.Errorf("unknown migration step: %v", step)
+	}
+	return e.migrateTo(&step)
+}
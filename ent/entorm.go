@@ -4,17 +4,27 @@ package ent
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"os"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	// 带钩子的 schema 使生成的校验器、钩子和拦截器注册在 ent/runtime 中
+	_ "github.com/benchplus/goorm/ent/runtime"
 	"github.com/benchplus/goorm/ent/user"
 	"github.com/benchplus/goorm/internal/models"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/benchplus/goorm/internal/sqldriver"
 )
 
 type EntORM struct {
 	client *Client
-	ctx    context.Context
+	drv    *entsql.Driver
+	// ctx 固定为 context.Background()，创建后只读，可在多个 goroutine 间共享
+	ctx context.Context
+	// prepared 为 true 时用 preparedDriver 包装驱动
+	prepared bool
 }
 
 func New() *EntORM {
@@ -23,12 +33,28 @@ func New() *EntORM {
 	}
 }
 
+// NewPrepared 创建驱动被 preparedDriver 包装的实现，生成的 SQL 以缓存的预编译语句执行
+func NewPrepared() *EntORM {
+	return &EntORM{
+		ctx:      context.Background(),
+		prepared: true,
+	}
+}
+
 func (e *EntORM) Init(dsn string) error {
-	client, err := Open("sqlite3", dsn)
+	db, err := sql.Open(sqldriver.Name(), dsn)
 	if err != nil {
 		return err
 	}
-	e.client = client
+	// 共享缓存的内存库中连接之间按表加锁，冲突时立即返回 SQLITE_LOCKED，不等待 busy_timeout，限制为单连接
+	db.SetMaxOpenConns(1)
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	e.drv = drv
+	if e.prepared {
+		e.client = NewClient(Driver(newPreparedDriver(drv)))
+	} else {
+		e.client = NewClient(Driver(drv))
+	}
 	return nil
 }
 
@@ -52,6 +78,8 @@ func (e *EntORM) Insert(userModel *models.User) error {
 		SetName(userModel.Name).
 		SetEmail(userModel.Email).
 		SetAge(userModel.Age).
+		SetCreatedAt(userModel.CreatedAt.UTC()).
+		SetUpdatedAt(userModel.UpdatedAt.UTC()).
 		Save(e.ctx)
 	if err != nil {
 		return err
@@ -70,7 +98,9 @@ func (e *EntORM) InsertBatch(users []*models.User) error {
 			Create().
 			SetName(u.Name).
 			SetEmail(u.Email).
-			SetAge(u.Age)
+			SetAge(u.Age).
+			SetCreatedAt(u.CreatedAt.UTC()).
+			SetUpdatedAt(u.UpdatedAt.UTC())
 	}
 	createdUsers, err := e.client.User.CreateBulk(builders...).Save(e.ctx)
 	if err != nil {
@@ -87,12 +117,7 @@ func (e *EntORM) GetByID(id int64) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &models.User{
-		ID:    u.ID,
-		Name:  u.Name,
-		Email: u.Email,
-		Age:   u.Age,
-	}, nil
+	return toUserModel(u), nil
 }
 
 func (e *EntORM) GetByIDs(ids []int64) ([]*models.User, error) {
@@ -102,16 +127,7 @@ func (e *EntORM) GetByIDs(ids []int64) ([]*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := make([]*models.User, len(users))
-	for i, u := range users {
-		result[i] = &models.User{
-			ID:    u.ID,
-			Name:  u.Name,
-			Email: u.Email,
-			Age:   u.Age,
-		}
-	}
-	return result, nil
+	return toUserModels(users), nil
 }
 
 func (e *EntORM) Update(userModel *models.User) error {
@@ -120,6 +136,8 @@ func (e *EntORM) Update(userModel *models.User) error {
 		SetName(userModel.Name).
 		SetEmail(userModel.Email).
 		SetAge(userModel.Age).
+		SetCreatedAt(userModel.CreatedAt.UTC()).
+		SetUpdatedAt(userModel.UpdatedAt.UTC()).
 		Save(e.ctx)
 	return err
 }
@@ -135,22 +153,89 @@ func (e *EntORM) Count() (int64, error) {
 
 func (e *EntORM) GetAll(limit, offset int) ([]*models.User, error) {
 	users, err := e.client.User.Query().
+		Order(user.ByID()).
 		Limit(limit).
 		Offset(offset).
 		All(e.ctx)
 	if err != nil {
 		return nil, err
 	}
+	return toUserModels(users), nil
+}
+
+func (e *EntORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	users, err := e.client.User.Query().
+		Where(user.CreatedAtGTE(from.UTC()), user.CreatedAtLT(to.UTC())).
+		Order(user.ByCreatedAt()).
+		All(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toUserModels(users), nil
+}
+
+func (e *EntORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	rows, err := e.drv.DB().QueryContext(e.ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*models.User
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, &u)
+	}
+	return result, rows.Err()
+}
+
+// iteratePageSize Iterate 按主键分页读取时每页的行数
+const iteratePageSize = 1000
+
+func (e *EntORM) Iterate(fn func(*models.User) error) error {
+	// ENT 没有游标 API，按主键做 keyset 分页，内存中最多保留一页
+	var lastID int64
+	for {
+		users, err := e.client.User.Query().
+			Where(user.IDGT(lastID)).
+			Order(user.ByID()).
+			Limit(iteratePageSize).
+			All(e.ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			if err := fn(toUserModel(u)); err != nil {
+				return err
+			}
+		}
+		if len(users) < iteratePageSize {
+			return nil
+		}
+		lastID = users[len(users)-1].ID
+	}
+}
+
+func toUserModel(u *User) *models.User {
+	return &models.User{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Age:       u.Age,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}
+}
+
+func toUserModels(users []*User) []*models.User {
 	result := make([]*models.User, len(users))
 	for i, u := range users {
-		result[i] = &models.User{
-			ID:    u.ID,
-			Name:  u.Name,
-			Email: u.Email,
-			Age:   u.Age,
-		}
+		result[i] = toUserModel(u)
 	}
-	return result, nil
+	return result
 }
 
 // GetDSN 生成测试用的 DSN
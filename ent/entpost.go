@@ -0,0 +1,45 @@
+package ent
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (e *EntORM) CreatePostTable() error {
+	// Schema.Create 已包含 posts 表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropPostTable() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.Post.Delete().Exec(e.ctx)
+	return nil
+}
+
+func (e *EntORM) InsertPost(postModel *models.Post) error {
+	p, err := e.client.Post.
+		Create().
+		SetUserID(postModel.UserID).
+		SetTitle(postModel.Title).
+		SetBody(postModel.Body).
+		Save(e.ctx)
+	if err != nil {
+		return err
+	}
+	postModel.ID = p.ID
+	return nil
+}
+
+func (e *EntORM) GetPostByID(id int64) (*models.Post, error) {
+	p, err := e.client.Post.Get(e.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Post{
+		ID:     p.ID,
+		UserID: p.UserID,
+		Title:  p.Title,
+		Body:   p.Body,
+	}, nil
+}
+
+func (e *EntORM) DeletePost(id int64) error {
+	return e.client.Post.DeleteOneID(id).Exec(e.ctx)
+}
@@ -0,0 +1,97 @@
+package ent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/benchplus/goorm/ent/predicate"
+	"github.com/benchplus/goorm/ent/softuser"
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// WhereP 追加不依赖生成包的存储层条件，供 schema.SoftDeleteMixin 的拦截器使用
+func (_q *SoftUserQuery) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SoftUser, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	_q.Where(p...)
+}
+
+func (e *EntORM) CreateSoftUserTable() error {
+	// Schema.Create 已包含 soft_users 表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropSoftUserTable() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.SoftUser.Delete().Exec(e.ctx)
+	return nil
+}
+
+func (e *EntORM) InsertSoftUser(userModel *models.User) error {
+	u, err := e.client.SoftUser.
+		Create().
+		SetName(userModel.Name).
+		SetEmail(userModel.Email).
+		SetAge(userModel.Age).
+		SetCreatedAt(userModel.CreatedAt.UTC()).
+		SetUpdatedAt(userModel.UpdatedAt.UTC()).
+		Save(e.ctx)
+	if err != nil {
+		return err
+	}
+	userModel.ID = u.ID
+	return nil
+}
+
+// DeleteSoftUser 拦截器只作用于查询，更新需显式排除已删除的记录
+func (e *EntORM) DeleteSoftUser(id int64) error {
+	_, err := e.client.SoftUser.
+		Update().
+		Where(softuser.ID(id), softuser.DeletedAtIsNil()).
+		SetDeletedAt(time.Now().UTC()).
+		Save(e.ctx)
+	return err
+}
+
+func (e *EntORM) GetSoftUserByID(id int64) (*models.User, error) {
+	u, err := e.client.SoftUser.Get(e.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return softUserModel(u), nil
+}
+
+func (e *EntORM) CountSoftUsers() (int64, error) {
+	count, err := e.client.SoftUser.Query().Count(e.ctx)
+	return int64(count), err
+}
+
+func (e *EntORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	users, err := e.client.SoftUser.Query().
+		Order(softuser.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.User, len(users))
+	for i, u := range users {
+		result[i] = softUserModel(u)
+	}
+	return result, nil
+}
+
+func softUserModel(u *SoftUser) *models.User {
+	return &models.User{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Age:       u.Age,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package ent
+
+import (
+	"github.com/benchplus/goorm/ent/tag"
+	"github.com/benchplus/goorm/ent/user"
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (e *EntORM) CreateTagTables() error {
+	// Schema.Create 已包含 tags 和 user_tags 表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropTagTables() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.UserTag.Delete().Exec(e.ctx)
+	_, _ = e.client.Tag.Delete().Exec(e.ctx)
+	return nil
+}
+
+func (e *EntORM) InsertTags(tags []*models.Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	builders := make([]*TagCreate, len(tags))
+	for i, t := range tags {
+		builders[i] = e.client.Tag.Create().SetName(t.Name)
+	}
+	created, err := e.client.Tag.CreateBulk(builders...).Save(e.ctx)
+	if err != nil {
+		return err
+	}
+	for i, t := range created {
+		tags[i].ID = t.ID
+	}
+	return nil
+}
+
+// AttachTags 通过 tags 边添加关联，ENT 插入连接表时忽略已有的关联
+func (e *EntORM) AttachTags(userID int64, tagIDs []int64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	return e.client.User.UpdateOneID(userID).AddTagIDs(tagIDs...).Exec(e.ctx)
+}
+
+func (e *EntORM) GetUsersWithTags(limit, offset int) ([]*models.TaggedUser, error) {
+	users, err := e.client.User.Query().
+		WithTags(func(q *TagQuery) {
+			q.Order(tag.ByID())
+		}).
+		Order(user.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.TaggedUser, len(users))
+	for i, u := range users {
+		tags := make([]*models.Tag, len(u.Edges.Tags))
+		for j, t := range u.Edges.Tags {
+			tags[j] = &models.Tag{ID: t.ID, Name: t.Name}
+		}
+		result[i] = &models.TaggedUser{User: *toUserModel(u), Tags: tags}
+	}
+	return result, nil
+}
+
+func (e *EntORM) GetUsersByTag(name string) ([]*models.User, error) {
+	users, err := e.client.User.Query().
+		Where(user.HasTagsWith(tag.Name(name))).
+		Order(user.ByID()).
+		All(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toUserModels(users), nil
+}
@@ -0,0 +1,65 @@
+package ent
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+func (e *EntORM) CreateVersionedTable() error {
+	// Schema.Create 已包含 versioned_users 表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropVersionedTable() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.VersionedUser.Delete().Exec(e.ctx)
+	return nil
+}
+
+func (e *EntORM) InsertVersioned(userModel *models.VersionedUser) error {
+	u, err := e.client.VersionedUser.
+		Create().
+		SetName(userModel.Name).
+		SetEmail(userModel.Email).
+		SetAge(userModel.Age).
+		Save(e.ctx)
+	if err != nil {
+		return err
+	}
+	userModel.ID = u.ID
+	userModel.Version = u.Version
+	return nil
+}
+
+// UpdateIfVersion SetVersion 传入期望的版本号，由 schema 中的钩子改写为条件更新
+func (e *EntORM) UpdateIfVersion(userModel *models.VersionedUser, expectedVersion int64) error {
+	u, err := e.client.VersionedUser.
+		UpdateOneID(userModel.ID).
+		SetName(userModel.Name).
+		SetEmail(userModel.Email).
+		SetAge(userModel.Age).
+		SetVersion(expectedVersion).
+		Save(e.ctx)
+	if IsNotFound(err) {
+		return orm.ErrVersionConflict
+	}
+	if err != nil {
+		return err
+	}
+	userModel.Version = u.Version
+	return nil
+}
+
+func (e *EntORM) GetVersionedByID(id int64) (*models.VersionedUser, error) {
+	u, err := e.client.VersionedUser.Get(e.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.VersionedUser{
+		ID:      u.ID,
+		Name:    u.Name,
+		Email:   u.Email,
+		Age:     u.Age,
+		Version: u.Version,
+	}, nil
+}
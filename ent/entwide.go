@@ -0,0 +1,15 @@
+package ent
+
+func (e *EntORM) CreateWideTables() error {
+	// Schema.Create 已包含所有宽表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropWideTables() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.Wide8.Delete().Exec(e.ctx)
+	_, _ = e.client.Wide16.Delete().Exec(e.ctx)
+	_, _ = e.client.Wide32.Delete().Exec(e.ctx)
+	_, _ = e.client.Wide64.Delete().Exec(e.ctx)
+	return nil
+}
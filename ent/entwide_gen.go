@@ -0,0 +1,361 @@
+// Code generated by internal/models/genwide.go; DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+
+	"github.com/benchplus/goorm/ent/wide16"
+	"github.com/benchplus/goorm/ent/wide32"
+	"github.com/benchplus/goorm/ent/wide64"
+	"github.com/benchplus/goorm/ent/wide8"
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (e *EntORM) InsertWide(row models.Wide) error {
+	switch w := row.(type) {
+	case *models.Wide8:
+		r, err := e.client.Wide8.Create().
+			SetC01(w.C01).
+			SetC02(w.C02).
+			SetC03(w.C03).
+			SetC04(w.C04).
+			SetC05(w.C05).
+			SetC06(w.C06).
+			SetC07(w.C07).
+			SetC08(w.C08).
+			Save(e.ctx)
+		if err != nil {
+			return err
+		}
+		w.ID = r.ID
+		return nil
+	case *models.Wide16:
+		r, err := e.client.Wide16.Create().
+			SetC01(w.C01).
+			SetC02(w.C02).
+			SetC03(w.C03).
+			SetC04(w.C04).
+			SetC05(w.C05).
+			SetC06(w.C06).
+			SetC07(w.C07).
+			SetC08(w.C08).
+			SetC09(w.C09).
+			SetC10(w.C10).
+			SetC11(w.C11).
+			SetC12(w.C12).
+			SetC13(w.C13).
+			SetC14(w.C14).
+			SetC15(w.C15).
+			SetC16(w.C16).
+			Save(e.ctx)
+		if err != nil {
+			return err
+		}
+		w.ID = r.ID
+		return nil
+	case *models.Wide32:
+		r, err := e.client.Wide32.Create().
+			SetC01(w.C01).
+			SetC02(w.C02).
+			SetC03(w.C03).
+			SetC04(w.C04).
+			SetC05(w.C05).
+			SetC06(w.C06).
+			SetC07(w.C07).
+			SetC08(w.C08).
+			SetC09(w.C09).
+			SetC10(w.C10).
+			SetC11(w.C11).
+			SetC12(w.C12).
+			SetC13(w.C13).
+			SetC14(w.C14).
+			SetC15(w.C15).
+			SetC16(w.C16).
+			SetC17(w.C17).
+			SetC18(w.C18).
+			SetC19(w.C19).
+			SetC20(w.C20).
+			SetC21(w.C21).
+			SetC22(w.C22).
+			SetC23(w.C23).
+			SetC24(w.C24).
+			SetC25(w.C25).
+			SetC26(w.C26).
+			SetC27(w.C27).
+			SetC28(w.C28).
+			SetC29(w.C29).
+			SetC30(w.C30).
+			SetC31(w.C31).
+			SetC32(w.C32).
+			Save(e.ctx)
+		if err != nil {
+			return err
+		}
+		w.ID = r.ID
+		return nil
+	case *models.Wide64:
+		r, err := e.client.Wide64.Create().
+			SetC01(w.C01).
+			SetC02(w.C02).
+			SetC03(w.C03).
+			SetC04(w.C04).
+			SetC05(w.C05).
+			SetC06(w.C06).
+			SetC07(w.C07).
+			SetC08(w.C08).
+			SetC09(w.C09).
+			SetC10(w.C10).
+			SetC11(w.C11).
+			SetC12(w.C12).
+			SetC13(w.C13).
+			SetC14(w.C14).
+			SetC15(w.C15).
+			SetC16(w.C16).
+			SetC17(w.C17).
+			SetC18(w.C18).
+			SetC19(w.C19).
+			SetC20(w.C20).
+			SetC21(w.C21).
+			SetC22(w.C22).
+			SetC23(w.C23).
+			SetC24(w.C24).
+			SetC25(w.C25).
+			SetC26(w.C26).
+			SetC27(w.C27).
+			SetC28(w.C28).
+			SetC29(w.C29).
+			SetC30(w.C30).
+			SetC31(w.C31).
+			SetC32(w.C32).
+			SetC33(w.C33).
+			SetC34(w.C34).
+			SetC35(w.C35).
+			SetC36(w.C36).
+			SetC37(w.C37).
+			SetC38(w.C38).
+			SetC39(w.C39).
+			SetC40(w.C40).
+			SetC41(w.C41).
+			SetC42(w.C42).
+			SetC43(w.C43).
+			SetC44(w.C44).
+			SetC45(w.C45).
+			SetC46(w.C46).
+			SetC47(w.C47).
+			SetC48(w.C48).
+			SetC49(w.C49).
+			SetC50(w.C50).
+			SetC51(w.C51).
+			SetC52(w.C52).
+			SetC53(w.C53).
+			SetC54(w.C54).
+			SetC55(w.C55).
+			SetC56(w.C56).
+			SetC57(w.C57).
+			SetC58(w.C58).
+			SetC59(w.C59).
+			SetC60(w.C60).
+			SetC61(w.C61).
+			SetC62(w.C62).
+			SetC63(w.C63).
+			SetC64(w.C64).
+			Save(e.ctx)
+		if err != nil {
+			return err
+		}
+		w.ID = r.ID
+		return nil
+	}
+	return fmt.Errorf("unsupported wide model %T", row)
+}
+
+func (e *EntORM) GetWide(cols, limit, offset int) ([]models.Wide, error) {
+	switch cols {
+	case 8:
+		rows, err := e.client.Wide8.Query().
+			Order(wide8.ByID()).
+			Limit(limit).
+			Offset(offset).
+			All(e.ctx)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]models.Wide, len(rows))
+		for i, r := range rows {
+			result[i] = &models.Wide8{
+				ID:  r.ID,
+				C01: r.C01,
+				C02: r.C02,
+				C03: r.C03,
+				C04: r.C04,
+				C05: r.C05,
+				C06: r.C06,
+				C07: r.C07,
+				C08: r.C08,
+			}
+		}
+		return result, nil
+	case 16:
+		rows, err := e.client.Wide16.Query().
+			Order(wide16.ByID()).
+			Limit(limit).
+			Offset(offset).
+			All(e.ctx)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]models.Wide, len(rows))
+		for i, r := range rows {
+			result[i] = &models.Wide16{
+				ID:  r.ID,
+				C01: r.C01,
+				C02: r.C02,
+				C03: r.C03,
+				C04: r.C04,
+				C05: r.C05,
+				C06: r.C06,
+				C07: r.C07,
+				C08: r.C08,
+				C09: r.C09,
+				C10: r.C10,
+				C11: r.C11,
+				C12: r.C12,
+				C13: r.C13,
+				C14: r.C14,
+				C15: r.C15,
+				C16: r.C16,
+			}
+		}
+		return result, nil
+	case 32:
+		rows, err := e.client.Wide32.Query().
+			Order(wide32.ByID()).
+			Limit(limit).
+			Offset(offset).
+			All(e.ctx)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]models.Wide, len(rows))
+		for i, r := range rows {
+			result[i] = &models.Wide32{
+				ID:  r.ID,
+				C01: r.C01,
+				C02: r.C02,
+				C03: r.C03,
+				C04: r.C04,
+				C05: r.C05,
+				C06: r.C06,
+				C07: r.C07,
+				C08: r.C08,
+				C09: r.C09,
+				C10: r.C10,
+				C11: r.C11,
+				C12: r.C12,
+				C13: r.C13,
+				C14: r.C14,
+				C15: r.C15,
+				C16: r.C16,
+				C17: r.C17,
+				C18: r.C18,
+				C19: r.C19,
+				C20: r.C20,
+				C21: r.C21,
+				C22: r.C22,
+				C23: r.C23,
+				C24: r.C24,
+				C25: r.C25,
+				C26: r.C26,
+				C27: r.C27,
+				C28: r.C28,
+				C29: r.C29,
+				C30: r.C30,
+				C31: r.C31,
+				C32: r.C32,
+			}
+		}
+		return result, nil
+	case 64:
+		rows, err := e.client.Wide64.Query().
+			Order(wide64.ByID()).
+			Limit(limit).
+			Offset(offset).
+			All(e.ctx)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]models.Wide, len(rows))
+		for i, r := range rows {
+			result[i] = &models.Wide64{
+				ID:  r.ID,
+				C01: r.C01,
+				C02: r.C02,
+				C03: r.C03,
+				C04: r.C04,
+				C05: r.C05,
+				C06: r.C06,
+				C07: r.C07,
+				C08: r.C08,
+				C09: r.C09,
+				C10: r.C10,
+				C11: r.C11,
+				C12: r.C12,
+				C13: r.C13,
+				C14: r.C14,
+				C15: r.C15,
+				C16: r.C16,
+				C17: r.C17,
+				C18: r.C18,
+				C19: r.C19,
+				C20: r.C20,
+				C21: r.C21,
+				C22: r.C22,
+				C23: r.C23,
+				C24: r.C24,
+				C25: r.C25,
+				C26: r.C26,
+				C27: r.C27,
+				C28: r.C28,
+				C29: r.C29,
+				C30: r.C30,
+				C31: r.C31,
+				C32: r.C32,
+				C33: r.C33,
+				C34: r.C34,
+				C35: r.C35,
+				C36: r.C36,
+				C37: r.C37,
+				C38: r.C38,
+				C39: r.C39,
+				C40: r.C40,
+				C41: r.C41,
+				C42: r.C42,
+				C43: r.C43,
+				C44: r.C44,
+				C45: r.C45,
+				C46: r.C46,
+				C47: r.C47,
+				C48: r.C48,
+				C49: r.C49,
+				C50: r.C50,
+				C51: r.C51,
+				C52: r.C52,
+				C53: r.C53,
+				C54: r.C54,
+				C55: r.C55,
+				C56: r.C56,
+				C57: r.C57,
+				C58: r.C58,
+				C59: r.C59,
+				C60: r.C60,
+				C61: r.C61,
+				C62: r.C62,
+				C63: r.C63,
+				C64: r.C64,
+			}
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unsupported wide model: %d columns", cols)
+}
@@ -0,0 +1,67 @@
+package ent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/benchplus/goorm/ent/profile"
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (e *EntORM) CreateJSONTable() error {
+	// Schema.Create 已包含 profiles 表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropJSONTable() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.Profile.Delete().Exec(e.ctx)
+	return nil
+}
+
+func (e *EntORM) InsertProfile(profileModel *models.Profile) error {
+	p, err := e.client.Profile.
+		Create().
+		SetName(profileModel.Name).
+		SetSettings(profileModel.Settings).
+		SetAttrs(profileModel.Attrs).
+		Save(e.ctx)
+	if err != nil {
+		return err
+	}
+	profileModel.ID = p.ID
+	return nil
+}
+
+func (e *EntORM) GetProfileByID(id int64) (*models.Profile, error) {
+	p, err := e.client.Profile.Get(e.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProfileModel(p), nil
+}
+
+func (e *EntORM) GetProfilesByTheme(theme string) ([]*models.Profile, error) {
+	profiles, err := e.client.Profile.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(profile.FieldSettings, theme, sqljson.Path("theme")))
+		}).
+		Order(profile.ByID()).
+		All(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.Profile, len(profiles))
+	for i, p := range profiles {
+		result[i] = toProfileModel(p)
+	}
+	return result, nil
+}
+
+func toProfileModel(p *Profile) *models.Profile {
+	return &models.Profile{
+		ID:       p.ID,
+		Name:     p.Name,
+		Settings: p.Settings,
+		Attrs:    p.Attrs,
+	}
+}
@@ -0,0 +1,119 @@
+package ent
+
+import (
+	"database/sql"
+
+	"github.com/benchplus/goorm/ent/nullableuser"
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (e *EntORM) CreateNullableTable() error {
+	// Schema.Create 已包含 nullable_users 表
+	return e.client.Schema.Create(e.ctx)
+}
+
+func (e *EntORM) DropNullableTable() error {
+	// Delete all records (ENT doesn't provide direct table drop)
+	_, _ = e.client.NullableUser.Delete().Exec(e.ctx)
+	return nil
+}
+
+func (e *EntORM) InsertNullable(userModel *models.NullableUser) error {
+	u, err := e.client.NullableUser.
+		Create().
+		SetNillableName(userModel.Name).
+		SetNillableEmail(nullStringPtr(userModel.Email)).
+		SetNillableAge(nullPtr(userModel.Age)).
+		Save(e.ctx)
+	if err != nil {
+		return err
+	}
+	userModel.ID = u.ID
+	return nil
+}
+
+func (e *EntORM) UpdateNullable(userModel *models.NullableUser) error {
+	// SetNillableX 遇到 nil 不会修改字段，需要显式 ClearX 写回 NULL
+	update := e.client.NullableUser.UpdateOneID(userModel.ID)
+	if userModel.Name != nil {
+		update.SetName(*userModel.Name)
+	} else {
+		update.ClearName()
+	}
+	if userModel.Email.Valid {
+		update.SetEmail(userModel.Email.String)
+	} else {
+		update.ClearEmail()
+	}
+	if userModel.Age.Valid {
+		update.SetAge(userModel.Age.V)
+	} else {
+		update.ClearAge()
+	}
+	_, err := update.Save(e.ctx)
+	return err
+}
+
+func (e *EntORM) GetNullableByID(id int64) (*models.NullableUser, error) {
+	u, err := e.client.NullableUser.Get(e.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toNullableModel(u), nil
+}
+
+func (e *EntORM) GetNullableAll(limit, offset int) ([]*models.NullableUser, error) {
+	users, err := e.client.NullableUser.Query().
+		Order(nullableuser.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.NullableUser, len(users))
+	for i, u := range users {
+		result[i] = toNullableModel(u)
+	}
+	return result, nil
+}
+
+// GetPlainAll 生成的实体字段是指针，对照路径直接扫描到非空结构体
+func (e *EntORM) GetPlainAll(limit, offset int) ([]*models.PlainUser, error) {
+	var users []*models.PlainUser
+	err := e.client.NullableUser.Query().
+		Order(nullableuser.ByID()).
+		Limit(limit).
+		Offset(offset).
+		Select(nullableuser.FieldID, nullableuser.FieldName, nullableuser.FieldEmail, nullableuser.FieldAge).
+		Scan(e.ctx, &users)
+	return users, err
+}
+
+func toNullableModel(u *NullableUser) *models.NullableUser {
+	user := &models.NullableUser{
+		ID:   u.ID,
+		Name: u.Name,
+	}
+	if u.Email != nil {
+		user.Email = sql.NullString{String: *u.Email, Valid: true}
+	}
+	if u.Age != nil {
+		user.Age = sql.Null[int64]{V: *u.Age, Valid: true}
+	}
+	return user
+}
+
+func nullStringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+func nullPtr[T any](n sql.Null[T]) *T {
+	if !n.Valid {
+		return nil
+	}
+	return &n.V
+}
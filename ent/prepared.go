@@ -0,0 +1,95 @@
+package ent
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// preparedDriver 包装 ENT 的 sql 驱动，Exec/Query 改用按 SQL 文本缓存的预编译语句。
+// 事务（Tx）仍走原驱动。
+type preparedDriver struct {
+	*entsql.Driver
+
+	mu    sync.Mutex
+	stmts map[string]*sql.Stmt
+}
+
+func newPreparedDriver(drv *entsql.Driver) *preparedDriver {
+	return &preparedDriver{Driver: drv, stmts: make(map[string]*sql.Stmt)}
+}
+
+// stmt 返回 query 对应的预编译语句，首次使用时编译并缓存
+func (d *preparedDriver) stmt(ctx context.Context, query string) (*sql.Stmt, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if stmt, ok := d.stmts[query]; ok {
+		return stmt, nil
+	}
+	stmt, err := d.DB().PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	d.stmts[query] = stmt
+	return stmt, nil
+}
+
+// Exec implements the dialect.Execer interface.
+func (d *preparedDriver) Exec(ctx context.Context, query string, args, v any) error {
+	argv, ok := args.([]any)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect []any for args", args)
+	}
+	stmt, err := d.stmt(ctx, query)
+	if err != nil {
+		return err
+	}
+	res, err := stmt.ExecContext(ctx, argv...)
+	if err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+	case *sql.Result:
+		*v = res
+	default:
+		return fmt.Errorf("dialect/sql: invalid type %T. expect *sql.Result", v)
+	}
+	return nil
+}
+
+// Query implements the dialect.Querier interface.
+func (d *preparedDriver) Query(ctx context.Context, query string, args, v any) error {
+	vr, ok := v.(*entsql.Rows)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect *sql.Rows", v)
+	}
+	argv, ok := args.([]any)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect []any for args", args)
+	}
+	stmt, err := d.stmt(ctx, query)
+	if err != nil {
+		return err
+	}
+	rows, err := stmt.QueryContext(ctx, argv...)
+	if err != nil {
+		return err
+	}
+	*vr = entsql.Rows{ColumnScanner: rows}
+	return nil
+}
+
+// Close 关闭缓存的语句和底层驱动
+func (d *preparedDriver) Close() error {
+	d.mu.Lock()
+	for _, stmt := range d.stmts {
+		stmt.Close()
+	}
+	d.stmts = nil
+	d.mu.Unlock()
+	return d.Driver.Close()
+}
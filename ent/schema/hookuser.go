@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"context"
+	"sync/atomic"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HookCalls HookUser 的钩子和拦截器被调用的累计次数
+var HookCalls atomic.Int64
+
+// HookUser holds the schema definition for the HookUser entity.
+// 字段与 User 相同，另外注册了只计数的钩子和拦截器，用于钩子开销测试
+type HookUser struct {
+	ent.Schema
+}
+
+// Fields of the HookUser.
+func (HookUser) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("email").
+			MaxLen(100).
+			NotEmpty(),
+		field.Int("age").
+			Positive(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+// Indexes of the HookUser.
+func (HookUser) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
+
+// Hooks of the HookUser. 变更（插入、更新）执行前后各计数一次
+func (HookUser) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				HookCalls.Add(1)
+				v, err := next.Mutate(ctx, m)
+				HookCalls.Add(1)
+				return v, err
+			})
+		},
+	}
+}
+
+// Interceptors of the HookUser. 查询执行前后各计数一次
+func (HookUser) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				HookCalls.Add(1)
+				v, err := next.Query(ctx, q)
+				HookCalls.Add(1)
+				return v, err
+			})
+		}),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// keyUserFields User 中 id 以外的字段
+func keyUserFields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("email").
+			MaxLen(100).
+			NotEmpty(),
+		field.Int("age").
+			Positive(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+// UUIDUser holds the schema definition for the UUIDUser entity.
+// 文本 UUID 主键，其余字段与 User 相同
+type UUIDUser struct {
+	ent.Schema
+}
+
+// Fields of the UUIDUser.
+func (UUIDUser) Fields() []ent.Field {
+	return append([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+	}, keyUserFields()...)
+}
+
+// Indexes of the UUIDUser.
+func (UUIDUser) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
+
+// SnowflakeUser holds the schema definition for the SnowflakeUser entity.
+// 客户端生成的 int64 主键，其余字段与 User 相同
+type SnowflakeUser struct {
+	ent.Schema
+}
+
+// Fields of the SnowflakeUser.
+func (SnowflakeUser) Fields() []ent.Field {
+	return append([]ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+	}, keyUserFields()...)
+}
+
+// Indexes of the SnowflakeUser.
+func (SnowflakeUser) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// NullableUser holds the schema definition for the NullableUser entity.
+type NullableUser struct {
+	ent.Schema
+}
+
+// Fields of the NullableUser.
+func (NullableUser) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("name").
+			MaxLen(100).
+			Optional().
+			Nillable(),
+		field.String("email").
+			MaxLen(100).
+			Optional().
+			Nillable(),
+		field.Int64("age").
+			Optional().
+			Nillable(),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+)
+
+// Post holds the schema definition for the Post entity.
+type Post struct {
+	ent.Schema
+}
+
+// Fields of the Post.
+func (Post) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.Int64("user_id"),
+		field.String("title").
+			MaxLen(200),
+		field.Bytes("body").
+			SchemaType(map[string]string{
+				dialect.SQLite: "text",
+			}),
+	}
+}
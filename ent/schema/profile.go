@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// Profile holds the schema definition for the Profile entity.
+type Profile struct {
+	ent.Schema
+}
+
+// Fields of the Profile.
+func (Profile) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("name").
+			MaxLen(100),
+		field.JSON("settings", models.ProfileSettings{}),
+		field.JSON("attrs", map[string]interface{}{}),
+	}
+}
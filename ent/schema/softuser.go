@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SoftDeleteMixin 为实体增加可空的 deleted_at 列及其索引，NULL 表示未删除，
+// 并为每次查询（包括 Get、Count）追加 deleted_at IS NULL。
+// 拦截器通过 WhereP 接口过滤，不引用生成的包，从空目录执行 go generate ./ent 也能编译；
+// 生成的查询类型没有 WhereP，由使用该 mixin 的实体在 ent 包中补上
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the SoftDeleteMixin.
+func (SoftDeleteMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+	}
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.TraverseFunc(func(_ context.Context, q ent.Query) error {
+			w, ok := q.(interface{ WhereP(...func(*sql.Selector)) })
+			if !ok {
+				return fmt.Errorf("soft delete: %T does not implement WhereP", q)
+			}
+			w.WhereP(sql.FieldIsNull("deleted_at"))
+			return nil
+		}),
+	}
+}
+
+// SoftUser holds the schema definition for the SoftUser entity.
+// 字段与 User 相同，由 SoftDeleteMixin 增加 deleted_at，用于软删除测试
+type SoftUser struct {
+	ent.Schema
+}
+
+// Mixin of the SoftUser.
+func (SoftUser) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the SoftUser.
+func (SoftUser) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("email").
+			MaxLen(100).
+			NotEmpty(),
+		field.Int("age").
+			Positive(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+// Indexes of the SoftUser.
+func (SoftUser) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -2,7 +2,9 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -24,5 +26,22 @@ func (User) Fields() []ent.Field {
 			NotEmpty(),
 		field.Int("age").
 			Positive(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tags", Tag.Type).
+			Through("user_tags", UserTag.Type),
 	}
 }
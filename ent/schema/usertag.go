@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserTag holds the schema definition for the UserTag entity.
+// User 与 Tag 多对多关联的连接表 user_tags。定义为边 schema 而不是默认生成的连接表，
+// 以便与其他实现一样在 tag_id 上建索引
+type UserTag struct {
+	ent.Schema
+}
+
+// Annotations of the UserTag.
+func (UserTag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("user_id", "tag_id"),
+	}
+}
+
+// Fields of the UserTag.
+func (UserTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		field.Int64("tag_id"),
+	}
+}
+
+// Edges of the UserTag.
+func (UserTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+		edge.To("tag", Tag.Type).
+			Unique().
+			Required().
+			Field("tag_id"),
+	}
+}
+
+// Indexes of the UserTag.
+func (UserTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tag_id"),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+// VersionedUser holds the schema definition for the VersionedUser entity.
+// 带版本号的用户，由 Hooks 中的钩子实现乐观锁
+type VersionedUser struct {
+	ent.Schema
+}
+
+// Fields of the VersionedUser.
+func (VersionedUser) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("email").
+			MaxLen(100).
+			NotEmpty(),
+		field.Int("age").
+			Positive(),
+		field.Int64("version").
+			Default(1),
+	}
+}
+
+// Hooks of the VersionedUser. UpdateOne 中设置的 version 视为期望的版本号：
+// 钩子追加 version = 期望值 的条件并把 version 改为期望值加一，版本不符时 UpdateOne 返回 NotFoundError
+func (VersionedUser) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if !m.Op().Is(ent.OpUpdateOne) {
+					return next.Mutate(ctx, m)
+				}
+				v, ok := m.Field("version")
+				if !ok {
+					return next.Mutate(ctx, m)
+				}
+				expected, ok := v.(int64)
+				if !ok {
+					return nil, fmt.Errorf("unexpected version type %T", v)
+				}
+				wm, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				wm.WhereP(sql.FieldEQ("version", expected))
+				if err := m.SetField("version", expected+1); err != nil {
+					return nil, err
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
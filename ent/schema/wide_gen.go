@@ -0,0 +1,184 @@
+// Code generated by internal/models/genwide.go; DO NOT EDIT.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Wide8 holds the schema definition for the 8-column wide entity.
+type Wide8 struct {
+	ent.Schema
+}
+
+// Fields of the Wide8.
+func (Wide8) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("c01"),
+		field.Int64("c02"),
+		field.Float("c03"),
+		field.Bool("c04"),
+		field.Time("c05"),
+		field.Bytes("c06"),
+		field.String("c07"),
+		field.Int64("c08"),
+	}
+}
+
+// Wide16 holds the schema definition for the 16-column wide entity.
+type Wide16 struct {
+	ent.Schema
+}
+
+// Fields of the Wide16.
+func (Wide16) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("c01"),
+		field.Int64("c02"),
+		field.Float("c03"),
+		field.Bool("c04"),
+		field.Time("c05"),
+		field.Bytes("c06"),
+		field.String("c07"),
+		field.Int64("c08"),
+		field.Float("c09"),
+		field.Bool("c10"),
+		field.Time("c11"),
+		field.Bytes("c12"),
+		field.String("c13"),
+		field.Int64("c14"),
+		field.Float("c15"),
+		field.Bool("c16"),
+	}
+}
+
+// Wide32 holds the schema definition for the 32-column wide entity.
+type Wide32 struct {
+	ent.Schema
+}
+
+// Fields of the Wide32.
+func (Wide32) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("c01"),
+		field.Int64("c02"),
+		field.Float("c03"),
+		field.Bool("c04"),
+		field.Time("c05"),
+		field.Bytes("c06"),
+		field.String("c07"),
+		field.Int64("c08"),
+		field.Float("c09"),
+		field.Bool("c10"),
+		field.Time("c11"),
+		field.Bytes("c12"),
+		field.String("c13"),
+		field.Int64("c14"),
+		field.Float("c15"),
+		field.Bool("c16"),
+		field.Time("c17"),
+		field.Bytes("c18"),
+		field.String("c19"),
+		field.Int64("c20"),
+		field.Float("c21"),
+		field.Bool("c22"),
+		field.Time("c23"),
+		field.Bytes("c24"),
+		field.String("c25"),
+		field.Int64("c26"),
+		field.Float("c27"),
+		field.Bool("c28"),
+		field.Time("c29"),
+		field.Bytes("c30"),
+		field.String("c31"),
+		field.Int64("c32"),
+	}
+}
+
+// Wide64 holds the schema definition for the 64-column wide entity.
+type Wide64 struct {
+	ent.Schema
+}
+
+// Fields of the Wide64.
+func (Wide64) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Immutable(),
+		field.String("c01"),
+		field.Int64("c02"),
+		field.Float("c03"),
+		field.Bool("c04"),
+		field.Time("c05"),
+		field.Bytes("c06"),
+		field.String("c07"),
+		field.Int64("c08"),
+		field.Float("c09"),
+		field.Bool("c10"),
+		field.Time("c11"),
+		field.Bytes("c12"),
+		field.String("c13"),
+		field.Int64("c14"),
+		field.Float("c15"),
+		field.Bool("c16"),
+		field.Time("c17"),
+		field.Bytes("c18"),
+		field.String("c19"),
+		field.Int64("c20"),
+		field.Float("c21"),
+		field.Bool("c22"),
+		field.Time("c23"),
+		field.Bytes("c24"),
+		field.String("c25"),
+		field.Int64("c26"),
+		field.Float("c27"),
+		field.Bool("c28"),
+		field.Time("c29"),
+		field.Bytes("c30"),
+		field.String("c31"),
+		field.Int64("c32"),
+		field.Float("c33"),
+		field.Bool("c34"),
+		field.Time("c35"),
+		field.Bytes("c36"),
+		field.String("c37"),
+		field.Int64("c38"),
+		field.Float("c39"),
+		field.Bool("c40"),
+		field.Time("c41"),
+		field.Bytes("c42"),
+		field.String("c43"),
+		field.Int64("c44"),
+		field.Float("c45"),
+		field.Bool("c46"),
+		field.Time("c47"),
+		field.Bytes("c48"),
+		field.String("c49"),
+		field.Int64("c50"),
+		field.Float("c51"),
+		field.Bool("c52"),
+		field.Time("c53"),
+		field.Bytes("c54"),
+		field.String("c55"),
+		field.Int64("c56"),
+		field.Float("c57"),
+		field.Bool("c58"),
+		field.Time("c59"),
+		field.Bytes("c60"),
+		field.String("c61"),
+		field.Int64("c62"),
+		field.Float("c63"),
+		field.Bool("c64"),
+	}
+}
@@ -0,0 +1,99 @@
+//go:build ignore
+// +build ignore
+
+// genvariants 为 orm.Variants 中的每个配置变体生成基准测试入口（variants_gen_test.go）。
+//
+// 入口按 Benchmark<Case>_<ORM>_<VARIANT> 命名，调用各 *_test.go 中
+// benchmark<Case>(b *testing.B, ormName string) 形式的辅助函数。
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+const output = "variants_gen_test.go"
+
+func main() {
+	files, err := filepath.Glob("*_test.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(files)
+
+	var cases []string
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if file == output {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && isCaseHelper(fn) {
+				cases = append(cases, strings.TrimPrefix(fn.Name.Name, "benchmark"))
+			}
+		}
+	}
+
+	variants := make([]string, 0, len(orm.Variants))
+	for name := range orm.Variants {
+		variants = append(variants, name)
+	}
+	sort.Strings(variants)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by genvariants.go; DO NOT EDIT.\n\npackage main\n\nimport \"testing\"\n")
+	for _, c := range cases {
+		fmt.Fprintf(&buf, "\n// Benchmark%s 配置变体\n", c)
+		for i, v := range variants {
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			suffix := strings.ToUpper(strings.ReplaceAll(v, "+", "_"))
+			fmt.Fprintf(&buf, "func Benchmark%s_%s(b *testing.B) {\nbenchmark%s(b, %q)\n}\n", c, suffix, c, v)
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format: %v\n%s", err, buf.Bytes())
+	}
+	if err := os.WriteFile(output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// isCaseHelper 判断是否为 benchmark<Case>(b *testing.B, ormName string) 形式的辅助函数
+func isCaseHelper(fn *ast.FuncDecl) bool {
+	if fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "benchmark") || len(fn.Name.Name) == len("benchmark") {
+		return false
+	}
+	params := fn.Type.Params.List
+	if len(params) != 2 || len(params[0].Names) != 1 || len(params[1].Names) != 1 {
+		return false
+	}
+	star, ok := params[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "B" {
+		return false
+	}
+	ident, ok := params[1].Type.(*ast.Ident)
+	return ok && ident.Name == "string"
+}
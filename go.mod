@@ -6,6 +6,8 @@ toolchain go1.24.12
 
 require (
 	entgo.io/ent v0.14.5
+	github.com/google/pprof v0.0.0-20250630185457-6e76a2b096b5
+	github.com/google/uuid v1.3.0
 	github.com/jmoiron/sqlx v1.3.5
 	github.com/mattn/go-sqlite3 v1.14.32
 	github.com/uptrace/bun v1.2.16
@@ -25,7 +27,6 @@ require (
 	github.com/goccy/go-json v0.8.1 // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
-	github.com/google/uuid v1.3.0 // indirect
 	github.com/hashicorp/hcl/v2 v2.18.1 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
@@ -3,15 +3,21 @@ package main
 import (
 	"fmt"
 	"os"
+	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/benchplus/goorm/borm"
 	"github.com/benchplus/goorm/bun"
+	"github.com/benchplus/goorm/direct"
 	"github.com/benchplus/goorm/ent"
 	"github.com/benchplus/goorm/gorm"
 	"github.com/benchplus/goorm/internal/models"
 	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
 	"github.com/benchplus/goorm/sqlx"
+	"github.com/benchplus/goorm/stdlib"
 	"github.com/benchplus/goorm/xorm"
 	"github.com/benchplus/goorm/zorm"
 )
@@ -49,25 +55,90 @@ var orms = map[string]struct {
 		init: func() orm.Interface { return ent.New() },
 		dsn:  ent.GetDSN,
 	},
+	// 配置变体，说明见 orm.Variants
+	"gorm+prepared": {
+		init: func() orm.Interface { return gorm.NewWithOptions(gorm.Options{PrepareStmt: true}) },
+		dsn:  gorm.GetDSN,
+	},
+	"gorm+notx": {
+		init: func() orm.Interface { return gorm.NewWithOptions(gorm.Options{SkipDefaultTransaction: true}) },
+		dsn:  gorm.GetDSN,
+	},
+	"gorm+tuned": {
+		init: func() orm.Interface {
+			return gorm.NewWithOptions(gorm.Options{PrepareStmt: true, SkipDefaultTransaction: true})
+		},
+		dsn: gorm.GetDSN,
+	},
+	"xorm+cache": {
+		init: func() orm.Interface { return xorm.NewWithCache(1000) },
+		dsn:  xorm.GetDSN,
+	},
+	"bun+prepared": {
+		init: func() orm.Interface { return bun.NewPrepared() },
+		dsn:  bun.GetDSN,
+	},
+	"ent+prepared": {
+		init: func() orm.Interface { return ent.NewPrepared() },
+		dsn:  ent.GetDSN,
+	},
+	"sqlx+prepared": {
+		init: func() orm.Interface { return sqlx.NewPrepared() },
+		dsn:  sqlx.GetDSN,
+	},
+	// stdlib 为纯 database/sql 参照实现，报告中各 ORM 的开销均相对它计算
+	"stdlib": {
+		init: func() orm.Interface { return stdlib.New() },
+		dsn:  stdlib.GetDSN,
+	},
+	"stdlib+prepared": {
+		init: func() orm.Interface { return stdlib.NewPrepared() },
+		dsn:  stdlib.GetDSN,
+	},
+	// direct 绕过 database/sql 直接调用驱动，是 stdlib 之下的理论下限
+	"direct": {
+		init: func() orm.Interface { return direct.New() },
+		dsn:  direct.GetDSN,
+	},
+}
+
+// benchTime 测试数据的 CreatedAt/UpdatedAt
+var benchTime = time.Date(2024, 1, 1, 12, 0, 0, 123456000, time.UTC)
+
+// ormNames 返回按名称排序的 ORM 列表，供一致性测试遍历
+func ormNames() []string {
+	names := make([]string, 0, len(orms))
+	for name := range orms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // setupORM 初始化 ORM
 func setupORM(name string) (orm.Interface, func(), error) {
+	orm, _, cleanup, err := setupORMWithDSN(name)
+	return orm, cleanup, err
+}
+
+// setupORMWithDSN 初始化 ORM，并返回其 DSN 供测试直接打开同一个数据库检查存储内容
+func setupORMWithDSN(name string) (orm.Interface, string, func(), error) {
 	ormInfo, ok := orms[name]
 	if !ok {
-		return nil, nil, fmt.Errorf("unknown ORM: %s", name)
+		return nil, "", nil, fmt.Errorf("unknown ORM: %s", name)
 	}
 
 	orm := ormInfo.init()
-	dsn := ormInfo.dsn()
+	// 按 GOORM_DRIVER 等设置追加驱动参数（如模拟延迟）
+	dsn := sqldriver.DSN(ormInfo.dsn())
 
 	if err := orm.Init(dsn); err != nil {
-		return nil, nil, err
+		return nil, "", nil, err
 	}
 
 	if err := orm.CreateTable(); err != nil {
 		orm.Close()
-		return nil, nil, err
+		return nil, "", nil, err
 	}
 
 	cleanup := func() {
@@ -79,7 +150,53 @@ func setupORM(name string) (orm.Interface, func(), error) {
 		}
 	}
 
-	return orm, cleanup, nil
+	return orm, dsn, cleanup, nil
+}
+
+// setupCapability 初始化 ORM 并断言其实现可选接口 T，未实现时跳过；create 建表，
+// 返回的清理函数先执行 drop 再关闭 ORM
+func setupCapability[T any](tb testing.TB, ormName string, create, drop func(T) error) (T, func()) {
+	_, c, _, teardown := setupCapabilityWithDSN(tb, ormName, create, drop)
+	return c, teardown
+}
+
+// setupCapabilityWithDSN 同 setupCapability，并返回 ORM 本身和 DSN
+func setupCapabilityWithDSN[T any](tb testing.TB, ormName string, create, drop func(T) error) (orm.Interface, T, string, func()) {
+	o, dsn, cleanup, err := setupORMWithDSN(ormName)
+	if err != nil {
+		tb.Fatalf("Setup failed: %v", err)
+	}
+	c, ok := o.(T)
+	if !ok {
+		cleanup()
+		tb.Skipf("%s does not implement %v", ormName, reflect.TypeFor[T]())
+	}
+	if err := create(c); err != nil {
+		cleanup()
+		tb.Fatalf("create tables for %v failed: %v", reflect.TypeFor[T](), err)
+	}
+	return o, c, dsn, func() {
+		drop(c)
+		cleanup()
+	}
+}
+
+// newUser 第 i 个测试用户，ID 由插入回填
+func newUser(i int) *models.User {
+	return &models.User{
+		Name:      fmt.Sprintf("user%d", i),
+		Email:     fmt.Sprintf("user%d@example.com", i),
+		Age:       20 + i%50,
+		CreatedAt: benchTime,
+		UpdatedAt: benchTime,
+	}
+}
+
+// skipIfCanned 空驱动按预制结果应答，无法计算范围条件，依赖范围查询行数的用例跳过
+func skipIfCanned(b *testing.B) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		b.Skip("null driver cannot evaluate range predicates")
+	}
 }
 
 // BenchmarkInsertSingle 单条插入测试
@@ -111,6 +228,14 @@ func BenchmarkInsertSingle_ENT(b *testing.B) {
 	benchmarkInsertSingle(b, "ent")
 }
 
+func BenchmarkInsertSingle_STDLIB(b *testing.B) {
+	benchmarkInsertSingle(b, "stdlib")
+}
+
+func BenchmarkInsertSingle_DIRECT(b *testing.B) {
+	benchmarkInsertSingle(b, "direct")
+}
+
 func benchmarkInsertSingle(b *testing.B, ormName string) {
 	orm, cleanup, err := setupORM(ormName)
 	if err != nil {
@@ -120,12 +245,15 @@ func benchmarkInsertSingle(b *testing.B, ormName string) {
 
 	b.ResetTimer()
 	b.ReportAllocs()
+	defer trackBenchmark(b).report()
 
 	for i := 0; i < b.N; i++ {
 		user := &models.User{
-			Name:  fmt.Sprintf("user%d", i),
-			Email: fmt.Sprintf("user%d@example.com", i),
-			Age:   20 + (i % 50),
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: benchTime,
+			UpdatedAt: benchTime,
 		}
 		if err := orm.Insert(user); err != nil {
 			b.Fatalf("Insert failed: %v", err)
@@ -162,6 +290,14 @@ func BenchmarkInsertBatch_ENT(b *testing.B) {
 	benchmarkInsertBatch(b, "ent")
 }
 
+func BenchmarkInsertBatch_STDLIB(b *testing.B) {
+	benchmarkInsertBatch(b, "stdlib")
+}
+
+func BenchmarkInsertBatch_DIRECT(b *testing.B) {
+	benchmarkInsertBatch(b, "direct")
+}
+
 func benchmarkInsertBatch(b *testing.B, ormName string) {
 	orm, cleanup, err := setupORM(ormName)
 	if err != nil {
@@ -174,13 +310,16 @@ func benchmarkInsertBatch(b *testing.B, ormName string) {
 
 	b.ResetTimer()
 	b.ReportAllocs()
+	defer trackBenchmark(b).report()
 
 	for i := 0; i < b.N; i++ {
 		for j := 0; j < batchSize; j++ {
 			users[j] = &models.User{
-				Name:  fmt.Sprintf("user%d_%d", i, j),
-				Email: fmt.Sprintf("user%d_%d@example.com", i, j),
-				Age:   20 + (j % 50),
+				Name:      fmt.Sprintf("user%d_%d", i, j),
+				Email:     fmt.Sprintf("user%d_%d@example.com", i, j),
+				Age:       20 + (j % 50),
+				CreatedAt: benchTime,
+				UpdatedAt: benchTime,
 			}
 		}
 		if err := orm.InsertBatch(users); err != nil {
@@ -218,6 +357,14 @@ func BenchmarkGetByID_ENT(b *testing.B) {
 	benchmarkGetByID(b, "ent")
 }
 
+func BenchmarkGetByID_STDLIB(b *testing.B) {
+	benchmarkGetByID(b, "stdlib")
+}
+
+func BenchmarkGetByID_DIRECT(b *testing.B) {
+	benchmarkGetByID(b, "direct")
+}
+
 func benchmarkGetByID(b *testing.B, ormName string) {
 	orm, cleanup, err := setupORM(ormName)
 	if err != nil {
@@ -229,9 +376,11 @@ func benchmarkGetByID(b *testing.B, ormName string) {
 	var ids []int64
 	for i := 0; i < 1000; i++ {
 		user := &models.User{
-			Name:  fmt.Sprintf("user%d", i),
-			Email: fmt.Sprintf("user%d@example.com", i),
-			Age:   20 + (i % 50),
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: benchTime,
+			UpdatedAt: benchTime,
 		}
 		if err := orm.Insert(user); err != nil {
 			b.Fatalf("Pre-insert failed: %v", err)
@@ -241,6 +390,7 @@ func benchmarkGetByID(b *testing.B, ormName string) {
 
 	b.ResetTimer()
 	b.ReportAllocs()
+	defer trackBenchmark(b).report()
 
 	for i := 0; i < b.N; i++ {
 		id := ids[i%len(ids)]
@@ -280,6 +430,14 @@ func BenchmarkGetByIDs_ENT(b *testing.B) {
 	benchmarkGetByIDs(b, "ent")
 }
 
+func BenchmarkGetByIDs_STDLIB(b *testing.B) {
+	benchmarkGetByIDs(b, "stdlib")
+}
+
+func BenchmarkGetByIDs_DIRECT(b *testing.B) {
+	benchmarkGetByIDs(b, "direct")
+}
+
 func benchmarkGetByIDs(b *testing.B, ormName string) {
 	orm, cleanup, err := setupORM(ormName)
 	if err != nil {
@@ -291,9 +449,11 @@ func benchmarkGetByIDs(b *testing.B, ormName string) {
 	var ids []int64
 	for i := 0; i < 1000; i++ {
 		user := &models.User{
-			Name:  fmt.Sprintf("user%d", i),
-			Email: fmt.Sprintf("user%d@example.com", i),
-			Age:   20 + (i % 50),
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: benchTime,
+			UpdatedAt: benchTime,
 		}
 		if err := orm.Insert(user); err != nil {
 			b.Fatalf("Pre-insert failed: %v", err)
@@ -304,6 +464,7 @@ func benchmarkGetByIDs(b *testing.B, ormName string) {
 	batchSize := 10
 	b.ResetTimer()
 	b.ReportAllocs()
+	defer trackBenchmark(b).report()
 
 	for i := 0; i < b.N; i++ {
 		start := (i * batchSize) % len(ids)
@@ -348,6 +509,14 @@ func BenchmarkUpdate_ENT(b *testing.B) {
 	benchmarkUpdate(b, "ent")
 }
 
+func BenchmarkUpdate_STDLIB(b *testing.B) {
+	benchmarkUpdate(b, "stdlib")
+}
+
+func BenchmarkUpdate_DIRECT(b *testing.B) {
+	benchmarkUpdate(b, "direct")
+}
+
 func benchmarkUpdate(b *testing.B, ormName string) {
 	orm, cleanup, err := setupORM(ormName)
 	if err != nil {
@@ -359,9 +528,11 @@ func benchmarkUpdate(b *testing.B, ormName string) {
 	var users []*models.User
 	for i := 0; i < 1000; i++ {
 		user := &models.User{
-			Name:  fmt.Sprintf("user%d", i),
-			Email: fmt.Sprintf("user%d@example.com", i),
-			Age:   20 + (i % 50),
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: benchTime,
+			UpdatedAt: benchTime,
 		}
 		if err := orm.Insert(user); err != nil {
 			b.Fatalf("Pre-insert failed: %v", err)
@@ -371,6 +542,7 @@ func benchmarkUpdate(b *testing.B, ormName string) {
 
 	b.ResetTimer()
 	b.ReportAllocs()
+	defer trackBenchmark(b).report()
 
 	for i := 0; i < b.N; i++ {
 		user := users[i%len(users)]
@@ -411,6 +583,14 @@ func BenchmarkDelete_ENT(b *testing.B) {
 	benchmarkDelete(b, "ent")
 }
 
+func BenchmarkDelete_STDLIB(b *testing.B) {
+	benchmarkDelete(b, "stdlib")
+}
+
+func BenchmarkDelete_DIRECT(b *testing.B) {
+	benchmarkDelete(b, "direct")
+}
+
 func benchmarkDelete(b *testing.B, ormName string) {
 	orm, cleanup, err := setupORM(ormName)
 	if err != nil {
@@ -422,9 +602,11 @@ func benchmarkDelete(b *testing.B, ormName string) {
 	var ids []int64
 	for i := 0; i < b.N+1000; i++ {
 		user := &models.User{
-			Name:  fmt.Sprintf("user%d", i),
-			Email: fmt.Sprintf("user%d@example.com", i),
-			Age:   20 + (i % 50),
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: benchTime,
+			UpdatedAt: benchTime,
 		}
 		if err := orm.Insert(user); err != nil {
 			b.Fatalf("Pre-insert failed: %v", err)
@@ -434,6 +616,7 @@ func benchmarkDelete(b *testing.B, ormName string) {
 
 	b.ResetTimer()
 	b.ReportAllocs()
+	defer trackBenchmark(b).report()
 
 	for i := 0; i < b.N; i++ {
 		if err := orm.Delete(ids[i]); err != nil {
@@ -471,6 +654,14 @@ func BenchmarkCount_ENT(b *testing.B) {
 	benchmarkCount(b, "ent")
 }
 
+func BenchmarkCount_STDLIB(b *testing.B) {
+	benchmarkCount(b, "stdlib")
+}
+
+func BenchmarkCount_DIRECT(b *testing.B) {
+	benchmarkCount(b, "direct")
+}
+
 func benchmarkCount(b *testing.B, ormName string) {
 	orm, cleanup, err := setupORM(ormName)
 	if err != nil {
@@ -481,9 +672,11 @@ func benchmarkCount(b *testing.B, ormName string) {
 	// 预先插入一些数据
 	for i := 0; i < 1000; i++ {
 		user := &models.User{
-			Name:  fmt.Sprintf("user%d", i),
-			Email: fmt.Sprintf("user%d@example.com", i),
-			Age:   20 + (i % 50),
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: benchTime,
+			UpdatedAt: benchTime,
 		}
 		if err := orm.Insert(user); err != nil {
 			b.Fatalf("Pre-insert failed: %v", err)
@@ -492,6 +685,7 @@ func benchmarkCount(b *testing.B, ormName string) {
 
 	b.ResetTimer()
 	b.ReportAllocs()
+	defer trackBenchmark(b).report()
 
 	for i := 0; i < b.N; i++ {
 		_, err := orm.Count()
@@ -530,6 +724,14 @@ func BenchmarkGetAll_ENT(b *testing.B) {
 	benchmarkGetAll(b, "ent")
 }
 
+func BenchmarkGetAll_STDLIB(b *testing.B) {
+	benchmarkGetAll(b, "stdlib")
+}
+
+func BenchmarkGetAll_DIRECT(b *testing.B) {
+	benchmarkGetAll(b, "direct")
+}
+
 func benchmarkGetAll(b *testing.B, ormName string) {
 	orm, cleanup, err := setupORM(ormName)
 	if err != nil {
@@ -540,9 +742,11 @@ func benchmarkGetAll(b *testing.B, ormName string) {
 	// 预先插入一些数据
 	for i := 0; i < 1000; i++ {
 		user := &models.User{
-			Name:  fmt.Sprintf("user%d", i),
-			Email: fmt.Sprintf("user%d@example.com", i),
-			Age:   20 + (i % 50),
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: benchTime,
+			UpdatedAt: benchTime,
 		}
 		if err := orm.Insert(user); err != nil {
 			b.Fatalf("Pre-insert failed: %v", err)
@@ -552,6 +756,7 @@ func benchmarkGetAll(b *testing.B, ormName string) {
 	limit := 100
 	b.ResetTimer()
 	b.ReportAllocs()
+	defer trackBenchmark(b).report()
 
 	for i := 0; i < b.N; i++ {
 		offset := (i * limit) % 900
@@ -561,3 +766,98 @@ func benchmarkGetAll(b *testing.B, ormName string) {
 		}
 	}
 }
+
+// rawUserQuery 原生查询路径使用的手写 SQL，与 GetAll 语义一致
+const rawUserQuery = "SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?"
+
+// BenchmarkRawQuery 原生 SQL 映射与构造器路径对比测试
+func BenchmarkRawQuery_GORM(b *testing.B) {
+	benchmarkRawQuery(b, "gorm")
+}
+
+func BenchmarkRawQuery_XORM(b *testing.B) {
+	benchmarkRawQuery(b, "xorm")
+}
+
+func BenchmarkRawQuery_ZORM(b *testing.B) {
+	benchmarkRawQuery(b, "zorm")
+}
+
+func BenchmarkRawQuery_SQLX(b *testing.B) {
+	benchmarkRawQuery(b, "sqlx")
+}
+
+func BenchmarkRawQuery_BORM(b *testing.B) {
+	benchmarkRawQuery(b, "borm")
+}
+
+func BenchmarkRawQuery_BUN(b *testing.B) {
+	benchmarkRawQuery(b, "bun")
+}
+
+func BenchmarkRawQuery_ENT(b *testing.B) {
+	benchmarkRawQuery(b, "ent")
+}
+
+func BenchmarkRawQuery_STDLIB(b *testing.B) {
+	benchmarkRawQuery(b, "stdlib")
+}
+
+func BenchmarkRawQuery_DIRECT(b *testing.B) {
+	benchmarkRawQuery(b, "direct")
+}
+
+func benchmarkRawQuery(b *testing.B, ormName string) {
+	orm, cleanup, err := setupORM(ormName)
+	if err != nil {
+		b.Fatalf("Setup failed: %v", err)
+	}
+	defer cleanup()
+
+	// 预先插入一些数据
+	for i := 0; i < 1000; i++ {
+		user := &models.User{
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: benchTime,
+			UpdatedAt: benchTime,
+		}
+		if err := orm.Insert(user); err != nil {
+			b.Fatalf("Pre-insert failed: %v", err)
+		}
+	}
+
+	limit := 100
+
+	// builder 走 ORM 的查询构造器，raw 走原生 SQL，两者返回相同的行
+	b.Run("builder", func(b *testing.B) {
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+		for i := 0; i < b.N; i++ {
+			offset := (i * limit) % 900
+			users, err := orm.GetAll(limit, offset)
+			if err != nil {
+				b.Fatalf("GetAll failed: %v", err)
+			}
+			if len(users) != limit {
+				b.Fatalf("GetAll returned %d rows, want %d", len(users), limit)
+			}
+		}
+	})
+
+	b.Run("raw", func(b *testing.B) {
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+		for i := 0; i < b.N; i++ {
+			offset := (i * limit) % 900
+			users, err := orm.RawQuery(rawUserQuery, limit, offset)
+			if err != nil {
+				b.Fatalf("RawQuery failed: %v", err)
+			}
+			if len(users) != limit {
+				b.Fatalf("RawQuery returned %d rows, want %d", len(users), limit)
+			}
+		}
+	})
+}
@@ -3,24 +3,52 @@ package gorm
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/sqldriver"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
 type GormORM struct {
-	db *gorm.DB
+	db   *gorm.DB
+	opts Options
+}
+
+// Options GORM 的可调配置，零值即 GORM 默认配置
+type Options struct {
+	// PrepareStmt 缓存并复用预编译语句
+	PrepareStmt bool
+	// SkipDefaultTransaction 写操作不再包在隐式事务中
+	SkipDefaultTransaction bool
 }
 
 func New() *GormORM {
 	return &GormORM{}
 }
 
+// NewWithOptions 创建使用指定配置的实现
+func NewWithOptions(opts Options) *GormORM {
+	return &GormORM{opts: opts}
+}
+
 func (g *GormORM) Init(dsn string) error {
 	var err error
-	g.db, err = gorm.Open(sqlite.Open(dsn), &gorm.Config{})
-	return err
+	g.db, err = gorm.Open(&sqlite.Dialector{DriverName: sqldriver.Name(), DSN: dsn}, &gorm.Config{
+		PrepareStmt:            g.opts.PrepareStmt,
+		SkipDefaultTransaction: g.opts.SkipDefaultTransaction,
+	})
+	if err != nil {
+		return err
+	}
+	sqlDB, err := g.db.DB()
+	if err != nil {
+		return err
+	}
+	// 共享缓存的内存库中连接之间按表加锁，冲突时立即返回 SQLITE_LOCKED，不等待 busy_timeout，限制为单连接
+	sqlDB.SetMaxOpenConns(1)
+	return nil
 }
 
 func (g *GormORM) Close() error {
@@ -40,11 +68,28 @@ func (g *GormORM) DropTable() error {
 }
 
 func (g *GormORM) Insert(user *models.User) error {
-	return g.db.Create(user).Error
+	row := user.InUTC()
+	if err := g.db.Create(row).Error; err != nil {
+		return err
+	}
+	user.ID = row.ID
+	return nil
 }
 
+// InsertBatch 在副本上转换时间字段后插入，再把回填的 ID 写回调用方的模型
 func (g *GormORM) InsertBatch(users []*models.User) error {
-	return g.db.CreateInBatches(users, 100).Error
+	rows := make([]models.User, len(users))
+	for i, user := range users {
+		rows[i] = *user
+		rows[i].UTC()
+	}
+	if err := g.db.CreateInBatches(&rows, 100).Error; err != nil {
+		return err
+	}
+	for i, user := range users {
+		user.ID = rows[i].ID
+	}
+	return nil
 }
 
 func (g *GormORM) GetByID(id int64) (*models.User, error) {
@@ -63,7 +108,7 @@ func (g *GormORM) GetByIDs(ids []int64) ([]*models.User, error) {
 }
 
 func (g *GormORM) Update(user *models.User) error {
-	return g.db.Save(user).Error
+	return g.db.Save(user.InUTC()).Error
 }
 
 func (g *GormORM) Delete(id int64) error {
@@ -78,10 +123,43 @@ func (g *GormORM) Count() (int64, error) {
 
 func (g *GormORM) GetAll(limit, offset int) ([]*models.User, error) {
 	var users []*models.User
-	err := g.db.Limit(limit).Offset(offset).Find(&users).Error
+	err := g.db.Order("id").Limit(limit).Offset(offset).Find(&users).Error
+	return users, err
+}
+
+func (g *GormORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	var users []*models.User
+	err := g.db.Where("created_at >= ? AND created_at < ?", from.UTC(), to.UTC()).
+		Order("created_at").
+		Find(&users).Error
 	return users, err
 }
 
+func (g *GormORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	var users []*models.User
+	err := g.db.Raw(query, args...).Scan(&users).Error
+	return users, err
+}
+
+func (g *GormORM) Iterate(fn func(*models.User) error) error {
+	rows, err := g.db.Model(&models.User{}).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user models.User
+		if err := g.db.ScanRows(rows, &user); err != nil {
+			return err
+		}
+		if err := fn(&user); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 // GetDSN 生成测试用的 DSN
 func GetDSN() string {
 	return fmt.Sprintf("file:%s?cache=shared&mode=memory", getTempFile())
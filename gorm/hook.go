@@ -0,0 +1,78 @@
+package gorm
+
+import (
+	"sync/atomic"
+
+	"github.com/benchplus/goorm/internal/models"
+	"gorm.io/gorm"
+)
+
+// hookCalls hookUser 的钩子被调用的累计次数，钩子是模型方法，由本包所有实例共享
+var hookCalls atomic.Int64
+
+// hookUser 与 models.User 结构相同、定义了 GORM 生命周期钩子的模型，钩子只计数
+type hookUser models.User
+
+func (hookUser) TableName() string {
+	return models.HookTable
+}
+
+func (*hookUser) BeforeCreate(*gorm.DB) error {
+	hookCalls.Add(1)
+	return nil
+}
+
+func (*hookUser) AfterCreate(*gorm.DB) error {
+	hookCalls.Add(1)
+	return nil
+}
+
+func (*hookUser) BeforeUpdate(*gorm.DB) error {
+	hookCalls.Add(1)
+	return nil
+}
+
+func (*hookUser) AfterUpdate(*gorm.DB) error {
+	hookCalls.Add(1)
+	return nil
+}
+
+// AfterFind GORM 没有查询前的钩子
+func (*hookUser) AfterFind(*gorm.DB) error {
+	hookCalls.Add(1)
+	return nil
+}
+
+func (g *GormORM) CreateHookTable() error {
+	return g.db.AutoMigrate(&hookUser{})
+}
+
+func (g *GormORM) DropHookTable() error {
+	return g.db.Migrator().DropTable(&hookUser{})
+}
+
+func (g *GormORM) InsertHooked(user *models.User) error {
+	row := user.InUTC()
+	if err := g.db.Create((*hookUser)(row)).Error; err != nil {
+		return err
+	}
+	user.ID = row.ID
+	return nil
+}
+
+func (g *GormORM) UpdateHooked(user *models.User) error {
+	return g.db.Save((*hookUser)(user.InUTC())).Error
+}
+
+func (g *GormORM) GetHookedByID(id int64) (*models.User, error) {
+	var user hookUser
+	err := g.db.First(&user, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return (*models.User)(&user), nil
+}
+
+func (g *GormORM) HookCalls() int64 {
+	return hookCalls.Load()
+}
@@ -0,0 +1,30 @@
+package gorm
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (g *GormORM) CreateJSONTable() error {
+	return g.db.AutoMigrate(&models.Profile{})
+}
+
+func (g *GormORM) DropJSONTable() error {
+	return g.db.Migrator().DropTable(&models.Profile{})
+}
+
+func (g *GormORM) InsertProfile(profile *models.Profile) error {
+	return g.db.Create(profile).Error
+}
+
+func (g *GormORM) GetProfileByID(id int64) (*models.Profile, error) {
+	var profile models.Profile
+	err := g.db.First(&profile, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (g *GormORM) GetProfilesByTheme(theme string) ([]*models.Profile, error) {
+	var profiles []*models.Profile
+	err := g.db.Where("json_extract(settings, '$.theme') = ?", theme).Order("id").Find(&profiles).Error
+	return profiles, err
+}
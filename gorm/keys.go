@@ -0,0 +1,63 @@
+package gorm
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
+
+func (g *GormORM) CreateKeyTables() error {
+	return g.db.AutoMigrate(&models.UUIDUser{}, &models.SnowflakeUser{})
+}
+
+func (g *GormORM) DropKeyTables() error {
+	return g.db.Migrator().DropTable(&models.UUIDUser{}, &models.SnowflakeUser{})
+}
+
+// InsertUUIDUser 主键由调用方设置，在副本上转换时间字段后插入，无需回填
+func (g *GormORM) InsertUUIDUser(user *models.UUIDUser) error {
+	row := *user
+	row.CreatedAt, row.UpdatedAt = row.CreatedAt.UTC(), row.UpdatedAt.UTC()
+	return g.db.Create(&row).Error
+}
+
+func (g *GormORM) InsertUUIDBatch(users []*models.UUIDUser) error {
+	rows := make([]models.UUIDUser, len(users))
+	for i, user := range users {
+		rows[i] = *user
+		rows[i].CreatedAt, rows[i].UpdatedAt = user.CreatedAt.UTC(), user.UpdatedAt.UTC()
+	}
+	return g.db.CreateInBatches(&rows, 100).Error
+}
+
+func (g *GormORM) GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error) {
+	var user models.UUIDUser
+	err := g.db.First(&user, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (g *GormORM) InsertSnowflakeUser(user *models.SnowflakeUser) error {
+	row := *user
+	row.CreatedAt, row.UpdatedAt = row.CreatedAt.UTC(), row.UpdatedAt.UTC()
+	return g.db.Create(&row).Error
+}
+
+func (g *GormORM) InsertSnowflakeBatch(users []*models.SnowflakeUser) error {
+	rows := make([]models.SnowflakeUser, len(users))
+	for i, user := range users {
+		rows[i] = *user
+		rows[i].CreatedAt, rows[i].UpdatedAt = user.CreatedAt.UTC(), user.UpdatedAt.UTC()
+	}
+	return g.db.CreateInBatches(&rows, 100).Error
+}
+
+func (g *GormORM) GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error) {
+	var user models.SnowflakeUser
+	err := g.db.First(&user, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
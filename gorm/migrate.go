@@ -0,0 +1,16 @@
+package gorm
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (g *GormORM) CreateMigrateTable() error {
+	return g.db.AutoMigrate(&models.MigrateUser{})
+}
+
+func (g *GormORM) DropMigrateTable() error {
+	return g.db.Migrator().DropTable(&models.MigrateUser{})
+}
+
+// Migrate 以目标结构调用 AutoMigrate，由 GORM 比对现有表结构后新增列、索引或修改列类型
+func (g *GormORM) Migrate(step models.MigrateStep) error {
+	return g.db.AutoMigrate(step.Model())
+}
@@ -0,0 +1,40 @@
+package gorm
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (g *GormORM) CreateNullableTable() error {
+	return g.db.AutoMigrate(&models.NullableUser{})
+}
+
+func (g *GormORM) DropNullableTable() error {
+	return g.db.Migrator().DropTable(&models.NullableUser{})
+}
+
+func (g *GormORM) InsertNullable(user *models.NullableUser) error {
+	return g.db.Create(user).Error
+}
+
+func (g *GormORM) UpdateNullable(user *models.NullableUser) error {
+	return g.db.Save(user).Error
+}
+
+func (g *GormORM) GetNullableByID(id int64) (*models.NullableUser, error) {
+	var user models.NullableUser
+	err := g.db.First(&user, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (g *GormORM) GetNullableAll(limit, offset int) ([]*models.NullableUser, error) {
+	var users []*models.NullableUser
+	err := g.db.Order("id").Limit(limit).Offset(offset).Find(&users).Error
+	return users, err
+}
+
+func (g *GormORM) GetPlainAll(limit, offset int) ([]*models.PlainUser, error) {
+	var users []*models.PlainUser
+	err := g.db.Order("id").Limit(limit).Offset(offset).Find(&users).Error
+	return users, err
+}
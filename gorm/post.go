@@ -0,0 +1,28 @@
+package gorm
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (g *GormORM) CreatePostTable() error {
+	return g.db.AutoMigrate(&models.Post{})
+}
+
+func (g *GormORM) DropPostTable() error {
+	return g.db.Migrator().DropTable(&models.Post{})
+}
+
+func (g *GormORM) InsertPost(post *models.Post) error {
+	return g.db.Create(post).Error
+}
+
+func (g *GormORM) GetPostByID(id int64) (*models.Post, error) {
+	var post models.Post
+	err := g.db.First(&post, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+func (g *GormORM) DeletePost(id int64) error {
+	return g.db.Delete(&models.Post{}, id).Error
+}
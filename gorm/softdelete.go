@@ -0,0 +1,66 @@
+package gorm
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"gorm.io/gorm"
+)
+
+// softUser 嵌入 models.User 并带 gorm.DeletedAt 字段：Delete 改为设置 deleted_at，
+// 查询和计数自动追加 deleted_at IS NULL
+type softUser struct {
+	models.User
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
+}
+
+func (softUser) TableName() string {
+	return models.SoftDeleteTable
+}
+
+func (g *GormORM) CreateSoftUserTable() error {
+	return g.db.AutoMigrate(&softUser{})
+}
+
+func (g *GormORM) DropSoftUserTable() error {
+	return g.db.Migrator().DropTable(&softUser{})
+}
+
+func (g *GormORM) InsertSoftUser(user *models.User) error {
+	row := &softUser{User: *user.InUTC()}
+	if err := g.db.Create(row).Error; err != nil {
+		return err
+	}
+	user.ID = row.ID
+	return nil
+}
+
+func (g *GormORM) DeleteSoftUser(id int64) error {
+	return g.db.Delete(&softUser{}, id).Error
+}
+
+func (g *GormORM) GetSoftUserByID(id int64) (*models.User, error) {
+	var user softUser
+	err := g.db.First(&user, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user.User, nil
+}
+
+func (g *GormORM) CountSoftUsers() (int64, error) {
+	var count int64
+	err := g.db.Model(&softUser{}).Count(&count).Error
+	return count, err
+}
+
+func (g *GormORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	var rows []softUser
+	err := g.db.Order("id").Limit(limit).Offset(offset).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*models.User, len(rows))
+	for i := range rows {
+		users[i] = &rows[i].User
+	}
+	return users, nil
+}
@@ -0,0 +1,70 @@
+package gorm
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"gorm.io/gorm"
+)
+
+// taggedUser 嵌入 models.User，Tags 经 user_tags 连接表多对多关联。
+// 连接表的结构由 models.UserTag 定义，见 CreateTagTables 中的 SetupJoinTable
+type taggedUser struct {
+	models.User
+	Tags []*models.Tag `gorm:"many2many:user_tags;joinForeignKey:UserID;joinReferences:TagID"`
+}
+
+func (taggedUser) TableName() string {
+	return models.User{}.TableName()
+}
+
+func (g *GormORM) CreateTagTables() error {
+	if err := g.db.SetupJoinTable(&taggedUser{}, "Tags", &models.UserTag{}); err != nil {
+		return err
+	}
+	return g.db.AutoMigrate(&models.Tag{}, &models.UserTag{})
+}
+
+func (g *GormORM) DropTagTables() error {
+	return g.db.Migrator().DropTable(&models.UserTag{}, &models.Tag{})
+}
+
+func (g *GormORM) InsertTags(tags []*models.Tag) error {
+	return g.db.CreateInBatches(tags, 100).Error
+}
+
+func (g *GormORM) AttachTags(userID int64, tagIDs []int64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	tags := make([]*models.Tag, len(tagIDs))
+	for i, id := range tagIDs {
+		tags[i] = &models.Tag{ID: id}
+	}
+	user := &taggedUser{User: models.User{ID: userID}}
+	// Omit("Tags.*") 只写连接表，不再 upsert 已存在的标签
+	return g.db.Model(user).Omit("Tags.*").Association("Tags").Append(tags)
+}
+
+func (g *GormORM) GetUsersWithTags(limit, offset int) ([]*models.TaggedUser, error) {
+	var rows []*taggedUser
+	err := g.db.Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tags.id")
+	}).Order("id").Limit(limit).Offset(offset).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*models.TaggedUser, len(rows))
+	for i, row := range rows {
+		users[i] = &models.TaggedUser{User: row.User, Tags: row.Tags}
+	}
+	return users, nil
+}
+
+func (g *GormORM) GetUsersByTag(name string) ([]*models.User, error) {
+	var users []*models.User
+	err := g.db.Joins("JOIN user_tags ON user_tags.user_id = users.id").
+		Joins("JOIN tags ON tags.id = user_tags.tag_id").
+		Where("tags.name = ?", name).
+		Order("users.id").
+		Find(&users).Error
+	return users, err
+}
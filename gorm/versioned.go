@@ -0,0 +1,49 @@
+package gorm
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"gorm.io/gorm"
+)
+
+func (g *GormORM) CreateVersionedTable() error {
+	return g.db.AutoMigrate(&models.VersionedUser{})
+}
+
+func (g *GormORM) DropVersionedTable() error {
+	return g.db.Migrator().DropTable(&models.VersionedUser{})
+}
+
+func (g *GormORM) InsertVersioned(user *models.VersionedUser) error {
+	user.Version = 1
+	return g.db.Create(user).Error
+}
+
+// UpdateIfVersion GORM 的乐观锁写法：带版本条件的 Updates 递增版本号，按影响行数判断冲突
+func (g *GormORM) UpdateIfVersion(user *models.VersionedUser, expectedVersion int64) error {
+	result := g.db.Model(&models.VersionedUser{}).
+		Where("id = ? AND version = ?", user.ID, expectedVersion).
+		Updates(map[string]interface{}{
+			"name":    user.Name,
+			"email":   user.Email,
+			"age":     user.Age,
+			"version": gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return orm.ErrVersionConflict
+	}
+	user.Version = expectedVersion + 1
+	return nil
+}
+
+func (g *GormORM) GetVersionedByID(id int64) (*models.VersionedUser, error) {
+	var user models.VersionedUser
+	err := g.db.First(&user, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,31 @@
+package gorm
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (g *GormORM) CreateWideTables() error {
+	for _, n := range models.WideWidths {
+		if err := g.db.AutoMigrate(models.NewWide(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *GormORM) DropWideTables() error {
+	for _, n := range models.WideWidths {
+		if err := g.db.Migrator().DropTable(models.NewWide(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *GormORM) InsertWide(row models.Wide) error {
+	return g.db.Create(row).Error
+}
+
+func (g *GormORM) GetWide(cols, limit, offset int) ([]models.Wide, error) {
+	rows := models.NewWideSlice(cols)
+	err := g.db.Order("id").Limit(limit).Offset(offset).Find(rows).Error
+	return models.WideRows(rows), err
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// setupHook 初始化 ORM 并创建带钩子模型的 hook_users 表，users 表作为无钩子的对照
+func setupHook(tb testing.TB, ormName string) (orm.Interface, orm.HookInterface, func()) {
+	o, h, _, teardown := setupCapabilityWithDSN(tb, ormName, orm.HookInterface.CreateHookTable, orm.HookInterface.DropHookTable)
+	return o, h, teardown
+}
+
+// TestHooks 验证带钩子的模型在插入、更新、查询时都触发钩子，数据正常往返，且钩子不作用于 users 表
+func TestHooks(t *testing.T) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		t.Skip("null driver returns canned results")
+	}
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			o, h, cleanup := setupHook(t, ormName)
+			defer cleanup()
+
+			// expectHooks 执行 fn 并检查钩子是否被调用
+			expectHooks := func(op string, want bool, fn func() error) {
+				t.Helper()
+				before := h.HookCalls()
+				if err := fn(); err != nil {
+					t.Fatalf("%s failed: %v", op, err)
+				}
+				if calls := h.HookCalls() - before; (calls > 0) != want {
+					t.Errorf("%s called hooks %d times, want hooks called: %v", op, calls, want)
+				}
+			}
+
+			user := newUser(1)
+			expectHooks("InsertHooked", true, func() error { return h.InsertHooked(user) })
+			user.Name = "renamed"
+			expectHooks("UpdateHooked", true, func() error { return h.UpdateHooked(user) })
+			var got *models.User
+			expectHooks("GetHookedByID", true, func() (err error) {
+				got, err = h.GetHookedByID(user.ID)
+				return err
+			})
+			if got.Name != user.Name || got.Email != user.Email || got.Age != user.Age {
+				t.Errorf("GetHookedByID = %+v, want %+v", got, user)
+			}
+
+			plain := newUser(2)
+			expectHooks("Insert", false, func() error { return o.Insert(plain) })
+			expectHooks("Update", false, func() error { return o.Update(plain) })
+			expectHooks("GetByID", false, func() error {
+				_, err := o.GetByID(plain.ID)
+				return err
+			})
+		})
+	}
+}
+
+// hookOps 钩子开销测试的操作，每个操作分别在无钩子的 users 表（plain）和带钩子的模型（hooked）上执行
+var hookOps = []string{"insert", "update", "select"}
+
+// BenchmarkHooks 生命周期钩子开销测试：插入、更新、查询在有无钩子时的对比，hooks/op 为每次操作触发的钩子数
+func BenchmarkHooks_GORM(b *testing.B) {
+	benchmarkHooks(b, "gorm")
+}
+
+func BenchmarkHooks_XORM(b *testing.B) {
+	benchmarkHooks(b, "xorm")
+}
+
+func BenchmarkHooks_ZORM(b *testing.B) {
+	benchmarkHooks(b, "zorm")
+}
+
+func BenchmarkHooks_SQLX(b *testing.B) {
+	benchmarkHooks(b, "sqlx")
+}
+
+func BenchmarkHooks_BORM(b *testing.B) {
+	benchmarkHooks(b, "borm")
+}
+
+func BenchmarkHooks_BUN(b *testing.B) {
+	benchmarkHooks(b, "bun")
+}
+
+func BenchmarkHooks_ENT(b *testing.B) {
+	benchmarkHooks(b, "ent")
+}
+
+func BenchmarkHooks_STDLIB(b *testing.B) {
+	benchmarkHooks(b, "stdlib")
+}
+
+func BenchmarkHooks_DIRECT(b *testing.B) {
+	benchmarkHooks(b, "direct")
+}
+
+func benchmarkHooks(b *testing.B, ormName string) {
+	o, h, cleanup := setupHook(b, ormName)
+	defer cleanup()
+
+	for _, op := range hookOps {
+		for _, hooked := range []bool{false, true} {
+			name := op + "/plain"
+			insert, update, get := o.Insert, o.Update, o.GetByID
+			if hooked {
+				name = op + "/hooked"
+				insert, update, get = h.InsertHooked, h.UpdateHooked, h.GetHookedByID
+			}
+			b.Run(name, func(b *testing.B) {
+				seed := newUser(0)
+				if err := insert(seed); err != nil {
+					b.Fatalf("Insert failed: %v", err)
+				}
+				var users []*models.User
+				if op == "insert" {
+					users = make([]*models.User, b.N)
+					for i := range users {
+						users[i] = newUser(i)
+					}
+				}
+				calls := h.HookCalls()
+				b.ResetTimer()
+				b.ReportAllocs()
+				tracker := trackBenchmark(b)
+				for i := 0; i < b.N; i++ {
+					var err error
+					switch op {
+					case "insert":
+						err = insert(users[i])
+					case "update":
+						seed.Age = 20 + i%50
+						err = update(seed)
+					case "select":
+						_, err = get(seed.ID)
+					}
+					if err != nil {
+						b.Fatalf("%s failed: %v", op, err)
+					}
+				}
+				tracker.report()
+				b.ReportMetric(float64(h.HookCalls()-calls)/float64(b.N), "hooks/op")
+			})
+		}
+	}
+}
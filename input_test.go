@@ -0,0 +1,235 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// hostileStrings 需要转义或多字节编码的字符串，所有实现都必须原样往返。
+// BUN 把参数内联进 SQL 文本，这些值同时检验其转义；其余实现按参数绑定传值
+var hostileStrings = []struct {
+	name  string
+	value string
+}{
+	{"single_quote", "O'Brien ''"},
+	{"double_quote", `say "hi" ""`},
+	{"backslash", `C:\tmp\' \\`},
+	{"nul", "a\x00b"},
+	{"emoji", "👩‍💻🚀 e\u0301"},
+	{"rtl", "שלום مرحبا \u202eabc"},
+	{"injection", "x'); DROP TABLE users; --"},
+	{"injection_or", "' OR '1'='1"},
+	{"max_length", strings.Repeat("a", 100)},
+}
+
+// invalidInputs 超出 ENT schema 约束的值：NotEmpty、MaxLen(100) 和 Positive。
+// ENT 拒绝写入，其余实现不做校验、原样保存（SQLite 不限制 VARCHAR 长度）
+var invalidInputs = []struct {
+	name   string
+	modify func(*models.User)
+}{
+	{"empty_name", func(u *models.User) { u.Name = "" }},
+	{"oversized_name", func(u *models.User) { u.Name = strings.Repeat("n", 101) }},
+	{"oversized_email", func(u *models.User) { u.Email = strings.Repeat("e", 1000) }},
+	{"age_zero", func(u *models.User) { u.Age = 0 }},
+	{"age_negative", func(u *models.User) { u.Age = -1 }},
+}
+
+// inlinedString 返回 s 经实现写入后读回的值。BUN 内联字符串字面量时丢弃 NUL 字节
+// （schema.BaseDialect.AppendString），bun+prepared 的批量插入和原生查询同样走内联；
+// 其余实现按参数绑定，原样保存
+func inlinedString(ormName, s string) string {
+	if ormName == "bun" || strings.HasPrefix(ormName, "bun+") {
+		return strings.ReplaceAll(s, "\x00", "")
+	}
+	return s
+}
+
+// validatesInput 报告实现是否按 ENT schema 校验输入
+func validatesInput(ormName string) bool {
+	return ormName == "ent" || strings.HasPrefix(ormName, "ent+")
+}
+
+// setupInput 初始化 ORM，空驱动按预制结果应答，无法检查往返
+func setupInput(t *testing.T, ormName string) (orm.Interface, func()) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		t.Skip("null driver returns canned results")
+	}
+	o, cleanup, err := setupORM(ormName)
+	if err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+	return o, cleanup
+}
+
+func newInputUser(name string) *models.User {
+	return &models.User{Name: name, Email: name, Age: 30, CreatedAt: benchTime, UpdatedAt: benchTime}
+}
+
+// assertUserStored 要求按 ID 读回的 Name、Email、Age 与 want 一致
+func assertUserStored(t *testing.T, o orm.Interface, want *models.User) {
+	t.Helper()
+	got, err := o.GetByID(want.ID)
+	if err != nil {
+		t.Fatalf("GetByID(%d) failed: %v", want.ID, err)
+	}
+	if got.Name != want.Name || got.Email != want.Email || got.Age != want.Age {
+		t.Errorf("stored {Name:%q Email:%q Age:%d}, want {Name:%q Email:%q Age:%d}",
+			got.Name, got.Email, got.Age, want.Name, want.Email, want.Age)
+	}
+}
+
+// TestHostileInput 验证引号、反斜杠、NUL、emoji、RTL 和注入字符串经过插入、更新、批量插入、
+// 按 ID 和原生 SQL 查询后原样往返，且没有改变表结构或其他行
+func TestHostileInput(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			o, cleanup := setupInput(t, ormName)
+			defer cleanup()
+
+			for _, tc := range hostileStrings {
+				t.Run(tc.name, func(t *testing.T) {
+					if stored := inlinedString(ormName, tc.value); stored != tc.value {
+						// 值会被改写，只检查改写结果，不再比较各条路径的往返
+						user := newInputUser(tc.value)
+						if err := o.InsertBatch([]*models.User{user}); err != nil {
+							t.Fatalf("InsertBatch failed: %v", err)
+						}
+						assertUserStored(t, o, &models.User{ID: user.ID, Name: stored, Email: stored, Age: user.Age})
+						return
+					}
+					user := newInputUser(tc.value)
+					if err := o.Insert(user); err != nil {
+						t.Fatalf("Insert failed: %v", err)
+					}
+					assertUserStored(t, o, user)
+
+					updated := newInputUser("plain")
+					updated.ID = user.ID
+					if err := o.Update(updated); err != nil {
+						t.Fatalf("Update failed: %v", err)
+					}
+					assertUserStored(t, o, updated)
+					updated.Name, updated.Email = tc.value, tc.value
+					if err := o.Update(updated); err != nil {
+						t.Fatalf("Update failed: %v", err)
+					}
+					assertUserStored(t, o, updated)
+
+					users, err := o.RawQuery("SELECT id, name, email, age, created_at, updated_at FROM users WHERE name = ?", tc.value)
+					if err != nil {
+						t.Fatalf("RawQuery failed: %v", err)
+					}
+					if len(users) != 1 || users[0].ID != user.ID {
+						t.Errorf("RawQuery by name returned IDs %v, want [%d]", userIDs(users), user.ID)
+					}
+				})
+			}
+
+			batch := make([]*models.User, len(hostileStrings))
+			for i, tc := range hostileStrings {
+				batch[i] = newInputUser(tc.value)
+			}
+			if err := o.InsertBatch(batch); err != nil {
+				t.Fatalf("InsertBatch failed: %v", err)
+			}
+			for _, user := range batch {
+				stored := *user
+				stored.Name, stored.Email = inlinedString(ormName, user.Name), inlinedString(ormName, user.Email)
+				assertUserStored(t, o, &stored)
+			}
+
+			// 不存在的 ID 和边界值不影响 IN 子句的结果
+			ids := append(userIDs(batch), 0, -1, math.MaxInt64, math.MinInt64)
+			users, err := o.GetByIDs(ids)
+			if err != nil {
+				t.Fatalf("GetByIDs failed: %v", err)
+			}
+			if got, want := userIDs(sortByID(users)), userIDs(batch); !equalIDs(got, want) {
+				t.Errorf("GetByIDs returned IDs %v, want %v", got, want)
+			}
+
+			count, err := o.Count()
+			if err != nil {
+				t.Fatalf("Count failed: %v", err)
+			}
+			if want := int64(2 * len(hostileStrings)); count != want {
+				t.Errorf("Count = %d, want %d", count, want)
+			}
+		})
+	}
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestInputValidation 记录各实现对空名字、超长字符串和非正年龄的处理差异：
+// ENT 按 schema 的 NotEmpty、MaxLen(100)、Positive 拒绝插入和更新，表内容不变；
+// 其余实现不做校验，值原样保存
+func TestInputValidation(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			o, cleanup := setupInput(t, ormName)
+			defer cleanup()
+			validates := validatesInput(ormName)
+
+			for _, tc := range invalidInputs {
+				t.Run(tc.name, func(t *testing.T) {
+					before, err := o.Count()
+					if err != nil {
+						t.Fatalf("Count failed: %v", err)
+					}
+					user := newInputUser("valid")
+					tc.modify(user)
+					err = o.Insert(user)
+					if validates {
+						if err == nil {
+							t.Fatalf("Insert accepted invalid input")
+						}
+						if after, _ := o.Count(); after != before {
+							t.Errorf("Count = %d after rejected Insert, want %d", after, before)
+						}
+					} else {
+						if err != nil {
+							t.Fatalf("Insert failed: %v", err)
+						}
+						assertUserStored(t, o, user)
+					}
+
+					valid := newInputUser("valid")
+					if err := o.Insert(valid); err != nil {
+						t.Fatalf("Insert failed: %v", err)
+					}
+					updated := *valid
+					tc.modify(&updated)
+					err = o.Update(&updated)
+					if validates {
+						if err == nil {
+							t.Fatalf("Update accepted invalid input")
+						}
+						assertUserStored(t, o, valid)
+					} else {
+						if err != nil {
+							t.Fatalf("Update failed: %v", err)
+						}
+						assertUserStored(t, o, &updated)
+					}
+				})
+			}
+		})
+	}
+}
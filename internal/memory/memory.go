@@ -0,0 +1,185 @@
+// Package memory orm.Interface 的内存参照实现，用于差分测试。
+//
+// 行保存在按 ID 索引的 map 中，ID 由计数器分配且删除后不复用，与 SQLite 的 AUTOINCREMENT 表一致；
+// 查询结果按 ID 升序返回，与 SQLite 按 rowid 扫描的顺序一致。不解析 SQL，RawQuery 返回错误。
+package memory
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+// ErrRawQuery RawQuery 不受支持
+var ErrRawQuery = errors.New("memory: RawQuery is not supported")
+
+// MemoryORM 以 map 存储 users 表
+type MemoryORM struct {
+	mu     sync.Mutex
+	rows   map[int64]models.User
+	nextID int64
+}
+
+var _ orm.Interface = (*MemoryORM)(nil)
+
+// New 创建空的参照实现，CreateTable 之前不可用
+func New() *MemoryORM {
+	return &MemoryORM{}
+}
+
+func (m *MemoryORM) Init(dsn string) error {
+	return nil
+}
+
+func (m *MemoryORM) Close() error {
+	return nil
+}
+
+func (m *MemoryORM) CreateTable() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.rows == nil {
+		m.rows = make(map[int64]models.User)
+	}
+	return nil
+}
+
+func (m *MemoryORM) DropTable() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rows = nil
+	m.nextID = 0
+	return nil
+}
+
+// insert 分配 ID 并保存副本，调用方持有锁
+func (m *MemoryORM) insert(user *models.User) error {
+	if m.rows == nil {
+		return fmt.Errorf("memory: no such table: users")
+	}
+	m.nextID++
+	user.ID = m.nextID
+	row := *user
+	row.UTC()
+	m.rows[row.ID] = row
+	return nil
+}
+
+func (m *MemoryORM) Insert(user *models.User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.insert(user)
+}
+
+func (m *MemoryORM) InsertBatch(users []*models.User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, user := range users {
+		if err := m.insert(user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MemoryORM) GetByID(id int64) (*models.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	row, ok := m.rows[id]
+	if !ok {
+		return nil, fmt.Errorf("user not found")
+	}
+	return &row, nil
+}
+
+// GetByIDs 与 WHERE id IN (...) 一致：重复的 ID 只返回一次，不存在的 ID 忽略
+func (m *MemoryORM) GetByIDs(ids []int64) ([]*models.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	want := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		want[id] = true
+	}
+	return m.sorted(func(u *models.User) bool { return want[u.ID] }), nil
+}
+
+// Update 与 UPDATE ... WHERE id = ? 一致：记录不存在时不做任何修改
+func (m *MemoryORM) Update(user *models.User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.rows[user.ID]; ok {
+		row := *user
+		row.UTC()
+		m.rows[row.ID] = row
+	}
+	return nil
+}
+
+func (m *MemoryORM) Delete(id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.rows, id)
+	return nil
+}
+
+func (m *MemoryORM) Count() (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return int64(len(m.rows)), nil
+}
+
+// GetAll 与 LIMIT ? OFFSET ? 一致，limit 为负数时不限制行数
+func (m *MemoryORM) GetAll(limit, offset int) ([]*models.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	users := m.sorted(nil)
+	users = users[min(max(offset, 0), len(users)):]
+	if limit >= 0 && limit < len(users) {
+		users = users[:limit]
+	}
+	return users, nil
+}
+
+func (m *MemoryORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	users := m.sorted(func(u *models.User) bool {
+		return !u.CreatedAt.Before(from) && u.CreatedAt.Before(to)
+	})
+	sort.SliceStable(users, func(i, j int) bool { return users[i].CreatedAt.Before(users[j].CreatedAt) })
+	return users, nil
+}
+
+func (m *MemoryORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	return nil, ErrRawQuery
+}
+
+func (m *MemoryORM) Iterate(fn func(*models.User) error) error {
+	m.mu.Lock()
+	users := m.sorted(nil)
+	m.mu.Unlock()
+	for _, user := range users {
+		if err := fn(user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sorted 返回满足 keep（为 nil 时全部）的行的副本，按 ID 升序，调用方持有锁
+func (m *MemoryORM) sorted(keep func(*models.User) bool) []*models.User {
+	users := make([]*models.User, 0, len(m.rows))
+	for _, row := range m.rows {
+		row := row
+		if keep == nil || keep(&row) {
+			users = append(users, &row)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
@@ -0,0 +1,216 @@
+//go:build ignore
+// +build ignore
+
+// genwide 生成宽表模型（Wide8 ~ Wide64）及对应的 ENT schema 和 ENT 实现。
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+// column 描述一种列类型在各 ORM 中的映射
+type column struct {
+	goType  string // Go 类型
+	xorm    string // xorm 列类型
+	ddl     string // 原生 DDL 列类型
+	entFunc string // ent field 构造函数
+	fill    string // 填充测试数据的表达式，%d 为列序号
+}
+
+// columnTypes 按列序号循环使用的列类型
+var columnTypes = []column{
+	{"string", "varchar(100)", "VARCHAR(100)", "String", `fmt.Sprintf("wide%%d_%d", seed)`},
+	{"int64", "bigint", "INTEGER", "Int64", `int64(seed) * %d`},
+	{"float64", "double", "REAL", "Float", `float64(seed) + 0.%d`},
+	{"bool", "bool", "BOOLEAN", "Bool", `(seed+%d)%%2 == 0`},
+	{"time.Time", "datetime", "DATETIME", "Time", `wideBaseTime.Add(time.Duration(seed+%d) * time.Second)`},
+	{"[]byte", "blob", "BLOB", "Bytes", `[]byte(fmt.Sprintf("blob%%d_%d", seed))`},
+}
+
+var widths = []int{8, 16, 32, 64}
+
+func colName(i int) string   { return fmt.Sprintf("c%02d", i) }
+func fieldName(i int) string { return fmt.Sprintf("C%02d", i) }
+func colType(i int) column   { return columnTypes[(i-1)%len(columnTypes)] }
+
+func main() {
+	write("wide_gen.go", genModels())
+	write("../../ent/schema/wide_gen.go", genEntSchema())
+	write("../../ent/entwide_gen.go", genEntORM())
+}
+
+func write(path string, src []byte) {
+	out, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("format %s: %v\n%s", path, err, src)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func header(buf *bytes.Buffer) {
+	buf.WriteString("// Code generated by internal/models/genwide.go; DO NOT EDIT.\n\n")
+}
+
+func genModels() []byte {
+	var buf bytes.Buffer
+	header(&buf)
+	buf.WriteString("package models\n\nimport (\n\"fmt\"\n\"time\"\n)\n\n")
+
+	for _, n := range widths {
+		name := fmt.Sprintf("Wide%d", n)
+		fmt.Fprintf(&buf, "// %s %d 列宽表模型\ntype %s struct {\n", name, n, name)
+		buf.WriteString("ID int64 `gorm:\"primaryKey\" xorm:\"pk autoincr 'id'\" json:\"id\" zorm:\"id,auto_incr\" borm:\"id\" bun:\"id,pk,autoincrement\"`\n")
+		for i := 1; i <= n; i++ {
+			c, t := colName(i), colType(i)
+			fmt.Fprintf(&buf, "%s %s `gorm:\"column:%s\" xorm:\"%s '%s'\" json:\"%s\" zorm:\"%s\" borm:\"%s\" bun:\"%s\"`\n",
+				fieldName(i), t.goType, c, t.xorm, c, c, c, c, c)
+		}
+		buf.WriteString("}\n\n")
+
+		fmt.Fprintf(&buf, "// TableName 表名\nfunc (%s) TableName() string {\nreturn %q\n}\n\n", name, tableName(n))
+		fmt.Fprintf(&buf, "func (w *%s) GetID() int64 {\nreturn w.ID\n}\n\n", name)
+		fmt.Fprintf(&buf, "func (w *%s) SetID(id int64) {\nw.ID = id\n}\n\n", name)
+		fmt.Fprintf(&buf, "func (w *%s) Columns() []string {\nreturn wide%dColumns\n}\n\n", name, n)
+
+		fmt.Fprintf(&buf, "func (w *%s) Values() []interface{} {\nreturn []interface{}{", name)
+		for i := 1; i <= n; i++ {
+			fmt.Fprintf(&buf, "w.%s, ", fieldName(i))
+		}
+		buf.WriteString("}\n}\n\n")
+
+		fmt.Fprintf(&buf, "func (w *%s) Pointers() []interface{} {\nreturn []interface{}{&w.ID, ", name)
+		for i := 1; i <= n; i++ {
+			fmt.Fprintf(&buf, "&w.%s, ", fieldName(i))
+		}
+		buf.WriteString("}\n}\n\n")
+
+		fmt.Fprintf(&buf, "func (w *%s) Fill(seed int) {\n", name)
+		for i := 1; i <= n; i++ {
+			fmt.Fprintf(&buf, "w.%s = %s\n", fieldName(i), fmt.Sprintf(colType(i).fill, i))
+		}
+		buf.WriteString("}\n\n")
+	}
+
+	for _, n := range widths {
+		cols := make([]string, n)
+		for i := range cols {
+			cols[i] = fmt.Sprintf("%q", colName(i+1))
+		}
+		fmt.Fprintf(&buf, "var wide%dColumns = []string{%s}\n\n", n, strings.Join(cols, ", "))
+	}
+
+	buf.WriteString("// NewWide 创建指定列数的宽表模型，列数不在 WideWidths 中时返回 nil\nfunc NewWide(cols int) Wide {\nswitch cols {\n")
+	for _, n := range widths {
+		fmt.Fprintf(&buf, "case %d:\nreturn &Wide%d{}\n", n, n)
+	}
+	buf.WriteString("}\nreturn nil\n}\n\n")
+
+	buf.WriteString("// NewWideSlice 创建指定列数的宽表切片指针（如 *[]*Wide8），供 ORM 查询填充\nfunc NewWideSlice(cols int) interface{} {\nswitch cols {\n")
+	for _, n := range widths {
+		fmt.Fprintf(&buf, "case %d:\nreturn &[]*Wide%d{}\n", n, n)
+	}
+	buf.WriteString("}\nreturn nil\n}\n\n")
+
+	buf.WriteString("// WideRows 将 NewWideSlice 返回的切片指针转换为 []Wide\nfunc WideRows(slice interface{}) []Wide {\nvar rows []Wide\nswitch s := slice.(type) {\n")
+	for _, n := range widths {
+		fmt.Fprintf(&buf, "case *[]*Wide%d:\nrows = make([]Wide, len(*s))\nfor i, w := range *s {\nrows[i] = w\n}\n", n)
+	}
+	buf.WriteString("}\nreturn rows\n}\n\n")
+
+	buf.WriteString("// WideDDL 返回宽表的建表语句\nfunc WideDDL(cols int) string {\nswitch cols {\n")
+	for _, n := range widths {
+		var ddl strings.Builder
+		fmt.Fprintf(&ddl, "CREATE TABLE IF NOT EXISTS %s (\n\tid INTEGER PRIMARY KEY AUTOINCREMENT", tableName(n))
+		for i := 1; i <= n; i++ {
+			fmt.Fprintf(&ddl, ",\n\t%s %s NOT NULL", colName(i), colType(i).ddl)
+		}
+		ddl.WriteString("\n)")
+		fmt.Fprintf(&buf, "case %d:\nreturn `%s`\n", n, ddl.String())
+	}
+	buf.WriteString("}\nreturn \"\"\n}\n\n")
+
+	buf.WriteString("// WideInsertSQL 返回宽表的单行插入语句\nfunc WideInsertSQL(cols int) string {\nswitch cols {\n")
+	for _, n := range widths {
+		names := make([]string, n)
+		marks := make([]string, n)
+		for i := range names {
+			names[i] = colName(i + 1)
+			marks[i] = "?"
+		}
+		fmt.Fprintf(&buf, "case %d:\nreturn %q\n", n,
+			fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", tableName(n), strings.Join(names, ", "), strings.Join(marks, ", ")))
+	}
+	buf.WriteString("}\nreturn \"\"\n}\n\n")
+
+	buf.WriteString("// WideSelectSQL 返回宽表的分页查询语句（按 ID 排序，LIMIT ? OFFSET ?）\nfunc WideSelectSQL(cols int) string {\nswitch cols {\n")
+	for _, n := range widths {
+		names := []string{"id"}
+		for i := 1; i <= n; i++ {
+			names = append(names, colName(i))
+		}
+		fmt.Fprintf(&buf, "case %d:\nreturn %q\n", n,
+			fmt.Sprintf("SELECT %s FROM %s ORDER BY id LIMIT ? OFFSET ?", strings.Join(names, ", "), tableName(n)))
+	}
+	buf.WriteString("}\nreturn \"\"\n}\n")
+	return buf.Bytes()
+}
+
+func tableName(n int) string {
+	return fmt.Sprintf("wide%ds", n)
+}
+
+func genEntSchema() []byte {
+	var buf bytes.Buffer
+	header(&buf)
+	buf.WriteString("package schema\n\nimport (\n\"entgo.io/ent\"\n\"entgo.io/ent/schema/field\"\n)\n\n")
+	for _, n := range widths {
+		name := fmt.Sprintf("Wide%d", n)
+		fmt.Fprintf(&buf, "// %s holds the schema definition for the %d-column wide entity.\ntype %s struct {\nent.Schema\n}\n\n", name, n, name)
+		fmt.Fprintf(&buf, "// Fields of the %s.\nfunc (%s) Fields() []ent.Field {\nreturn []ent.Field{\nfield.Int64(\"id\").\nPositive().\nImmutable(),\n", name, name)
+		for i := 1; i <= n; i++ {
+			fmt.Fprintf(&buf, "field.%s(%q),\n", colType(i).entFunc, colName(i))
+		}
+		buf.WriteString("}\n}\n\n")
+	}
+	return buf.Bytes()
+}
+
+func genEntORM() []byte {
+	var buf bytes.Buffer
+	header(&buf)
+	buf.WriteString("package ent\n\nimport (\n\"fmt\"\n\n")
+	for _, n := range widths {
+		fmt.Fprintf(&buf, "\"github.com/benchplus/goorm/ent/wide%d\"\n", n)
+	}
+	buf.WriteString("\"github.com/benchplus/goorm/internal/models\"\n)\n\n")
+
+	buf.WriteString("func (e *EntORM) InsertWide(row models.Wide) error {\nswitch w := row.(type) {\n")
+	for _, n := range widths {
+		fmt.Fprintf(&buf, "case *models.Wide%d:\nr, err := e.client.Wide%d.Create().\n", n, n)
+		for i := 1; i <= n; i++ {
+			fmt.Fprintf(&buf, "Set%s(w.%s).\n", fieldName(i), fieldName(i))
+		}
+		buf.WriteString("Save(e.ctx)\nif err != nil {\nreturn err\n}\nw.ID = r.ID\nreturn nil\n")
+	}
+	buf.WriteString("}\nreturn fmt.Errorf(\"unsupported wide model %T\", row)\n}\n\n")
+
+	buf.WriteString("func (e *EntORM) GetWide(cols, limit, offset int) ([]models.Wide, error) {\nswitch cols {\n")
+	for _, n := range widths {
+		fmt.Fprintf(&buf, "case %d:\nrows, err := e.client.Wide%d.Query().\nOrder(wide%d.ByID()).\nLimit(limit).\nOffset(offset).\nAll(e.ctx)\n", n, n, n)
+		buf.WriteString("if err != nil {\nreturn nil, err\n}\nresult := make([]models.Wide, len(rows))\nfor i, r := range rows {\n")
+		fmt.Fprintf(&buf, "result[i] = &models.Wide%d{\nID: r.ID,\n", n)
+		for i := 1; i <= n; i++ {
+			fmt.Fprintf(&buf, "%s: r.%s,\n", fieldName(i), fieldName(i))
+		}
+		buf.WriteString("}\n}\nreturn result, nil\n")
+	}
+	buf.WriteString("}\nreturn nil, fmt.Errorf(\"unsupported wide model: %d columns\", cols)\n}\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,4 @@
+package models
+
+// HookTable 钩子测试使用的表名。各实现以 User 为底层类型定义带钩子的模型，结构与 users 表相同
+const HookTable = "hook_users"
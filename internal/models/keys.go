@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UUIDUser 文本 UUID 主键的用户模型，其余列与 User 相同。ID 由客户端生成，以 36 个字符的文本存储。
+// xorm 把数组类型编码为 JSON，ID 列由 xorm 实现中的包装类型映射
+type UUIDUser struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:varchar(36)" xorm:"-" json:"id" zorm:"id" borm:"id" bun:"id,pk,type:varchar(36)"`
+	Name      string    `gorm:"column:name" xorm:"varchar(100) 'name'" json:"name" zorm:"name" borm:"name" bun:"name"`
+	Email     string    `gorm:"column:email" xorm:"varchar(100) 'email'" json:"email" zorm:"email" borm:"email" bun:"email"`
+	Age       int       `gorm:"column:age" xorm:"int 'age'" json:"age" zorm:"age" borm:"age" bun:"age"`
+	CreatedAt time.Time `gorm:"column:created_at;index;autoCreateTime:false" xorm:"datetime(6) index 'created_at'" json:"created_at" zorm:"created_at" borm:"created_at" bun:"created_at,notnull" db:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime:false" xorm:"datetime(6) 'updated_at'" json:"updated_at" zorm:"updated_at" borm:"updated_at" bun:"updated_at,notnull" db:"updated_at"`
+}
+
+// TableName 表名
+func (UUIDUser) TableName() string {
+	return "uuid_users"
+}
+
+// SnowflakeUser 客户端生成 int64 主键（snowflake 风格，按时间递增）的用户模型，其余列与 User 相同。
+// 主键不自增，插入时使用调用方设置的 ID
+type SnowflakeUser struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement:false" xorm:"pk 'id'" json:"id" zorm:"id" borm:"id" bun:"id,pk"`
+	Name      string    `gorm:"column:name" xorm:"varchar(100) 'name'" json:"name" zorm:"name" borm:"name" bun:"name"`
+	Email     string    `gorm:"column:email" xorm:"varchar(100) 'email'" json:"email" zorm:"email" borm:"email" bun:"email"`
+	Age       int       `gorm:"column:age" xorm:"int 'age'" json:"age" zorm:"age" borm:"age" bun:"age"`
+	CreatedAt time.Time `gorm:"column:created_at;index;autoCreateTime:false" xorm:"datetime(6) index 'created_at'" json:"created_at" zorm:"created_at" borm:"created_at" bun:"created_at,notnull" db:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime:false" xorm:"datetime(6) 'updated_at'" json:"updated_at" zorm:"updated_at" borm:"updated_at" bun:"updated_at,notnull" db:"updated_at"`
+}
+
+// TableName 表名
+func (SnowflakeUser) TableName() string {
+	return "snowflake_users"
+}
@@ -0,0 +1,89 @@
+package models
+
+// MigrateTable 迁移测试使用的表名
+const MigrateTable = "migrate_users"
+
+// MigrateUser migrate_users 的初始结构，各迁移步骤都从它开始
+type MigrateUser struct {
+	ID    int64  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" bun:"id,pk,autoincrement"`
+	Name  string `gorm:"column:name;type:varchar(50)" xorm:"varchar(50) 'name'" json:"name" bun:"name,type:varchar(50)"`
+	Email string `gorm:"column:email;type:varchar(100)" xorm:"varchar(100) 'email'" json:"email" bun:"email,type:varchar(100)"`
+	Age   int    `gorm:"column:age" xorm:"int 'age'" json:"age" bun:"age"`
+}
+
+// MigrateUserNickname 新增可空列 nickname 后的结构
+type MigrateUserNickname struct {
+	ID       int64   `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" bun:"id,pk,autoincrement"`
+	Name     string  `gorm:"column:name;type:varchar(50)" xorm:"varchar(50) 'name'" json:"name" bun:"name,type:varchar(50)"`
+	Email    string  `gorm:"column:email;type:varchar(100)" xorm:"varchar(100) 'email'" json:"email" bun:"email,type:varchar(100)"`
+	Age      int     `gorm:"column:age" xorm:"int 'age'" json:"age" bun:"age"`
+	Nickname *string `gorm:"column:nickname;type:varchar(50)" xorm:"varchar(50) 'nickname'" json:"nickname" bun:"nickname,type:varchar(50)"`
+}
+
+// MigrateUserIndexed email 带索引的结构
+type MigrateUserIndexed struct {
+	ID    int64  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" bun:"id,pk,autoincrement"`
+	Name  string `gorm:"column:name;type:varchar(50)" xorm:"varchar(50) 'name'" json:"name" bun:"name,type:varchar(50)"`
+	Email string `gorm:"column:email;type:varchar(100);index:idx_migrate_users_email" xorm:"varchar(100) index(migrate_users_email) 'email'" json:"email" bun:"email,type:varchar(100)"`
+	Age   int    `gorm:"column:age" xorm:"int 'age'" json:"age" bun:"age"`
+}
+
+// MigrateUserWide name 由 varchar(50) 加宽到 varchar(200) 后的结构
+type MigrateUserWide struct {
+	ID    int64  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" bun:"id,pk,autoincrement"`
+	Name  string `gorm:"column:name;type:varchar(200)" xorm:"varchar(200) 'name'" json:"name" bun:"name,type:varchar(200)"`
+	Email string `gorm:"column:email;type:varchar(100)" xorm:"varchar(100) 'email'" json:"email" bun:"email,type:varchar(100)"`
+	Age   int    `gorm:"column:age" xorm:"int 'age'" json:"age" bun:"age"`
+}
+
+// TableName 表名
+func (MigrateUser) TableName() string { return MigrateTable }
+
+// TableName 表名
+func (MigrateUserNickname) TableName() string { return MigrateTable }
+
+// TableName 表名
+func (MigrateUserIndexed) TableName() string { return MigrateTable }
+
+// TableName 表名
+func (MigrateUserWide) TableName() string { return MigrateTable }
+
+// MigrateStep 迁移测试中对 migrate_users 的一次结构修改
+type MigrateStep int
+
+const (
+	// MigrateAddColumn 新增可空列 nickname varchar(50)
+	MigrateAddColumn MigrateStep = iota
+	// MigrateAddIndex 为 email 新增索引
+	MigrateAddIndex
+	// MigrateWidenColumn name 由 varchar(50) 加宽到 varchar(200)
+	MigrateWidenColumn
+)
+
+// MigrateSteps 迁移测试覆盖的所有步骤
+var MigrateSteps = []MigrateStep{MigrateAddColumn, MigrateAddIndex, MigrateWidenColumn}
+
+func (s MigrateStep) String() string {
+	switch s {
+	case MigrateAddColumn:
+		return "add_column"
+	case MigrateAddIndex:
+		return "add_index"
+	case MigrateWidenColumn:
+		return "widen_varchar"
+	}
+	return "unknown"
+}
+
+// Model 返回步骤完成后的目标结构，供按模型迁移的 ORM 使用
+func (s MigrateStep) Model() interface{} {
+	switch s {
+	case MigrateAddColumn:
+		return &MigrateUserNickname{}
+	case MigrateAddIndex:
+		return &MigrateUserIndexed{}
+	case MigrateWidenColumn:
+		return &MigrateUserWide{}
+	}
+	return &MigrateUser{}
+}
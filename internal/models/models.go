@@ -1,14 +1,46 @@
 package models
 
+import "time"
+
 // User 测试用的用户模型
+//
+// CreatedAt/UpdatedAt 由调用方赋值，各实现均关闭 ORM 自带的自动时间戳，
+// 并以 UTC 写入，保证以文本存储时可按字典序做范围比较。
 type User struct {
-	ID    int64  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
-	Name  string `gorm:"column:name" xorm:"varchar(100) 'name'" json:"name" zorm:"name" borm:"name" bun:"name"`
-	Email string `gorm:"column:email" xorm:"varchar(100) 'email'" json:"email" zorm:"email" borm:"email" bun:"email"`
-	Age   int    `gorm:"column:age" xorm:"int 'age'" json:"age" zorm:"age" borm:"age" bun:"age"`
+	ID        int64     `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	Name      string    `gorm:"column:name" xorm:"varchar(100) 'name'" json:"name" zorm:"name" borm:"name" bun:"name"`
+	Email     string    `gorm:"column:email" xorm:"varchar(100) 'email'" json:"email" zorm:"email" borm:"email" bun:"email"`
+	Age       int       `gorm:"column:age" xorm:"int 'age'" json:"age" zorm:"age" borm:"age" bun:"age"`
+	CreatedAt time.Time `gorm:"column:created_at;index;autoCreateTime:false" xorm:"datetime(6) index 'created_at'" json:"created_at" zorm:"created_at" borm:"created_at" bun:"created_at,notnull" db:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime:false" xorm:"datetime(6) 'updated_at'" json:"updated_at" zorm:"updated_at" borm:"updated_at" bun:"updated_at,notnull" db:"updated_at"`
 }
 
 // TableName 表名
 func (User) TableName() string {
 	return "users"
 }
+
+// UTC 将时间字段转换为 UTC（时刻不变）
+func (u *User) UTC() {
+	u.CreatedAt = u.CreatedAt.UTC()
+	u.UpdatedAt = u.UpdatedAt.UTC()
+}
+
+// InUTC 返回时间字段转换为 UTC 的副本，不修改调用方的模型
+func (u User) InUTC() *User {
+	u.UTC()
+	return &u
+}
+
+// Post 文章模型，Body 对应 posts.body TEXT 列，用于大字段测试
+type Post struct {
+	ID     int64  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	UserID int64  `gorm:"column:user_id" xorm:"bigint 'user_id'" json:"user_id" zorm:"user_id" borm:"user_id" bun:"user_id" db:"user_id"`
+	Title  string `gorm:"column:title;type:varchar(200)" xorm:"varchar(200) 'title'" json:"title" zorm:"title" borm:"title" bun:"title"`
+	Body   []byte `gorm:"column:body;type:text" xorm:"text 'body'" json:"body" zorm:"body" borm:"body" bun:"body,type:text"`
+}
+
+// TableName 表名
+func (Post) TableName() string {
+	return "posts"
+}
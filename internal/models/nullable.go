@@ -0,0 +1,29 @@
+package models
+
+import "database/sql"
+
+// NullableUser 可空列模型，覆盖指针、sql.NullString 和 sql.Null[T] 三种表示
+type NullableUser struct {
+	ID    int64           `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	Name  *string         `gorm:"column:name;type:varchar(100)" xorm:"varchar(100) 'name'" json:"name" zorm:"name" borm:"name" bun:"name,type:varchar(100)"`
+	Email sql.NullString  `gorm:"column:email;type:varchar(100)" xorm:"varchar(100) 'email'" json:"email" zorm:"email" borm:"email" bun:"email,type:varchar(100)"`
+	Age   sql.Null[int64] `gorm:"column:age;type:integer" xorm:"integer 'age'" json:"age" zorm:"age" borm:"age" bun:"age,type:integer"`
+}
+
+// TableName 表名
+func (NullableUser) TableName() string {
+	return "nullable_users"
+}
+
+// PlainUser 与 NullableUser 读取同一张表的同样四列，字段为非空类型，作为可空列读取的对照
+type PlainUser struct {
+	ID    int64  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	Name  string `gorm:"column:name;type:varchar(100)" xorm:"varchar(100) 'name'" json:"name" zorm:"name" borm:"name" bun:"name,type:varchar(100)"`
+	Email string `gorm:"column:email;type:varchar(100)" xorm:"varchar(100) 'email'" json:"email" zorm:"email" borm:"email" bun:"email,type:varchar(100)"`
+	Age   int64  `gorm:"column:age;type:integer" xorm:"integer 'age'" json:"age" zorm:"age" borm:"age" bun:"age,type:integer"`
+}
+
+// TableName 表名
+func (PlainUser) TableName() string {
+	return "nullable_users"
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// ProfileSettings 以 JSON 存储的结构体
+type ProfileSettings struct {
+	Theme    string   `json:"theme"`
+	Language string   `json:"language"`
+	Notify   bool     `json:"notify"`
+	Tags     []string `json:"tags"`
+}
+
+// Profile JSON 列模型，Settings 为结构体，Attrs 为 map
+type Profile struct {
+	ID       int64                  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	Name     string                 `gorm:"column:name" xorm:"varchar(100) 'name'" json:"name" zorm:"name" borm:"name" bun:"name"`
+	Settings ProfileSettings        `gorm:"column:settings;type:text;serializer:json" xorm:"text json 'settings'" json:"settings" zorm:"settings" borm:"settings" bun:"settings,type:json"`
+	Attrs    map[string]interface{} `gorm:"column:attrs;type:text;serializer:json" xorm:"text json 'attrs'" json:"attrs" zorm:"attrs" borm:"attrs" bun:"attrs,type:json"`
+}
+
+// TableName 表名
+func (Profile) TableName() string {
+	return "profiles"
+}
+
+// JSON 基于 encoding/json 的 Valuer/Scanner，供 database/sql 实现读写 JSON 列
+//
+// V 必须为指针，例如 JSON{&profile.Settings}。
+type JSON struct {
+	V interface{}
+}
+
+// Value 实现 driver.Valuer，以 TEXT 写入
+func (j JSON) Value() (driver.Value, error) {
+	b, err := json.Marshal(j.V)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan 实现 sql.Scanner
+func (j JSON) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, j.V)
+	case string:
+		return json.Unmarshal([]byte(v), j.V)
+	case nil:
+		return nil
+	}
+	return fmt.Errorf("unsupported JSON source type %T", src)
+}
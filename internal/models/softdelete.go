@@ -0,0 +1,5 @@
+package models
+
+// SoftDeleteTable 软删除测试使用的表名。各实现在 User 的列之外增加可空的 deleted_at 列，
+// NULL 表示未删除
+const SoftDeleteTable = "soft_users"
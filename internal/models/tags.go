@@ -0,0 +1,29 @@
+package models
+
+// Tag 标签模型，Name 唯一。与 User 经 user_tags 连接表多对多关联
+type Tag struct {
+	ID   int64  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	Name string `gorm:"column:name;type:varchar(100);not null;uniqueIndex" xorm:"varchar(100) notnull unique 'name'" json:"name" zorm:"name" borm:"name" bun:"name,type:varchar(100),notnull,unique"`
+}
+
+// TableName 表名
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// UserTag user_tags 连接表的一行，主键为 (user_id, tag_id)，tag_id 上另有索引用于按标签查询用户
+type UserTag struct {
+	UserID int64 `gorm:"primaryKey;autoIncrement:false" xorm:"pk 'user_id'" json:"user_id" zorm:"user_id" borm:"user_id" bun:"user_id,pk" db:"user_id"`
+	TagID  int64 `gorm:"primaryKey;autoIncrement:false;index" xorm:"pk index 'tag_id'" json:"tag_id" zorm:"tag_id" borm:"tag_id" bun:"tag_id,pk" db:"tag_id"`
+}
+
+// TableName 表名
+func (UserTag) TableName() string {
+	return "user_tags"
+}
+
+// TaggedUser 用户及其标签，Tags 按标签 ID 升序，没有标签时为空
+type TaggedUser struct {
+	User
+	Tags []*Tag
+}
@@ -0,0 +1,15 @@
+package models
+
+// VersionedUser 带版本号的用户模型，用于乐观锁测试。插入时 Version 为 1，每次成功的条件更新加一
+type VersionedUser struct {
+	ID      int64  `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	Name    string `gorm:"column:name" xorm:"varchar(100) 'name'" json:"name" zorm:"name" borm:"name" bun:"name"`
+	Email   string `gorm:"column:email" xorm:"varchar(100) 'email'" json:"email" zorm:"email" borm:"email" bun:"email"`
+	Age     int    `gorm:"column:age" xorm:"int 'age'" json:"age" zorm:"age" borm:"age" bun:"age"`
+	Version int64  `gorm:"column:version" xorm:"version 'version'" json:"version" zorm:"version" borm:"version" bun:"version,notnull"`
+}
+
+// TableName 表名
+func (VersionedUser) TableName() string {
+	return "versioned_users"
+}
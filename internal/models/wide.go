@@ -0,0 +1,31 @@
+//go:generate go run genwide.go
+
+package models
+
+import "time"
+
+// WideWidths 宽表模型的列数梯度（不含 id 主键）
+var WideWidths = []int{8, 16, 32, 64}
+
+// wideBaseTime 宽表模型时间列的基准时间
+var wideBaseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Wide 宽表模型的公共方法，供手写 SQL 的实现按列读写
+//
+// Wide8 ~ Wide64 由 genwide.go 生成，列类型依次循环
+// string、int64、float64、bool、time.Time、[]byte。
+type Wide interface {
+	TableName() string
+	// GetID 返回主键
+	GetID() int64
+	// SetID 设置主键
+	SetID(id int64)
+	// Columns 返回除 id 外的列名
+	Columns() []string
+	// Values 返回除 id 外的列值，顺序与 Columns 一致
+	Values() []interface{}
+	// Pointers 返回 id 及各列字段的指针，用于 Scan
+	Pointers() []interface{}
+	// Fill 按 seed 填充确定性的测试数据
+	Fill(seed int)
+}
@@ -0,0 +1,619 @@
+// Code generated by internal/models/genwide.go; DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Wide8 8 列宽表模型
+type Wide8 struct {
+	ID  int64     `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	C01 string    `gorm:"column:c01" xorm:"varchar(100) 'c01'" json:"c01" zorm:"c01" borm:"c01" bun:"c01"`
+	C02 int64     `gorm:"column:c02" xorm:"bigint 'c02'" json:"c02" zorm:"c02" borm:"c02" bun:"c02"`
+	C03 float64   `gorm:"column:c03" xorm:"double 'c03'" json:"c03" zorm:"c03" borm:"c03" bun:"c03"`
+	C04 bool      `gorm:"column:c04" xorm:"bool 'c04'" json:"c04" zorm:"c04" borm:"c04" bun:"c04"`
+	C05 time.Time `gorm:"column:c05" xorm:"datetime 'c05'" json:"c05" zorm:"c05" borm:"c05" bun:"c05"`
+	C06 []byte    `gorm:"column:c06" xorm:"blob 'c06'" json:"c06" zorm:"c06" borm:"c06" bun:"c06"`
+	C07 string    `gorm:"column:c07" xorm:"varchar(100) 'c07'" json:"c07" zorm:"c07" borm:"c07" bun:"c07"`
+	C08 int64     `gorm:"column:c08" xorm:"bigint 'c08'" json:"c08" zorm:"c08" borm:"c08" bun:"c08"`
+}
+
+// TableName 表名
+func (Wide8) TableName() string {
+	return "wide8s"
+}
+
+func (w *Wide8) GetID() int64 {
+	return w.ID
+}
+
+func (w *Wide8) SetID(id int64) {
+	w.ID = id
+}
+
+func (w *Wide8) Columns() []string {
+	return wide8Columns
+}
+
+func (w *Wide8) Values() []interface{} {
+	return []interface{}{w.C01, w.C02, w.C03, w.C04, w.C05, w.C06, w.C07, w.C08}
+}
+
+func (w *Wide8) Pointers() []interface{} {
+	return []interface{}{&w.ID, &w.C01, &w.C02, &w.C03, &w.C04, &w.C05, &w.C06, &w.C07, &w.C08}
+}
+
+func (w *Wide8) Fill(seed int) {
+	w.C01 = fmt.Sprintf("wide%d_1", seed)
+	w.C02 = int64(seed) * 2
+	w.C03 = float64(seed) + 0.3
+	w.C04 = (seed+4)%2 == 0
+	w.C05 = wideBaseTime.Add(time.Duration(seed+5) * time.Second)
+	w.C06 = []byte(fmt.Sprintf("blob%d_6", seed))
+	w.C07 = fmt.Sprintf("wide%d_7", seed)
+	w.C08 = int64(seed) * 8
+}
+
+// Wide16 16 列宽表模型
+type Wide16 struct {
+	ID  int64     `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	C01 string    `gorm:"column:c01" xorm:"varchar(100) 'c01'" json:"c01" zorm:"c01" borm:"c01" bun:"c01"`
+	C02 int64     `gorm:"column:c02" xorm:"bigint 'c02'" json:"c02" zorm:"c02" borm:"c02" bun:"c02"`
+	C03 float64   `gorm:"column:c03" xorm:"double 'c03'" json:"c03" zorm:"c03" borm:"c03" bun:"c03"`
+	C04 bool      `gorm:"column:c04" xorm:"bool 'c04'" json:"c04" zorm:"c04" borm:"c04" bun:"c04"`
+	C05 time.Time `gorm:"column:c05" xorm:"datetime 'c05'" json:"c05" zorm:"c05" borm:"c05" bun:"c05"`
+	C06 []byte    `gorm:"column:c06" xorm:"blob 'c06'" json:"c06" zorm:"c06" borm:"c06" bun:"c06"`
+	C07 string    `gorm:"column:c07" xorm:"varchar(100) 'c07'" json:"c07" zorm:"c07" borm:"c07" bun:"c07"`
+	C08 int64     `gorm:"column:c08" xorm:"bigint 'c08'" json:"c08" zorm:"c08" borm:"c08" bun:"c08"`
+	C09 float64   `gorm:"column:c09" xorm:"double 'c09'" json:"c09" zorm:"c09" borm:"c09" bun:"c09"`
+	C10 bool      `gorm:"column:c10" xorm:"bool 'c10'" json:"c10" zorm:"c10" borm:"c10" bun:"c10"`
+	C11 time.Time `gorm:"column:c11" xorm:"datetime 'c11'" json:"c11" zorm:"c11" borm:"c11" bun:"c11"`
+	C12 []byte    `gorm:"column:c12" xorm:"blob 'c12'" json:"c12" zorm:"c12" borm:"c12" bun:"c12"`
+	C13 string    `gorm:"column:c13" xorm:"varchar(100) 'c13'" json:"c13" zorm:"c13" borm:"c13" bun:"c13"`
+	C14 int64     `gorm:"column:c14" xorm:"bigint 'c14'" json:"c14" zorm:"c14" borm:"c14" bun:"c14"`
+	C15 float64   `gorm:"column:c15" xorm:"double 'c15'" json:"c15" zorm:"c15" borm:"c15" bun:"c15"`
+	C16 bool      `gorm:"column:c16" xorm:"bool 'c16'" json:"c16" zorm:"c16" borm:"c16" bun:"c16"`
+}
+
+// TableName 表名
+func (Wide16) TableName() string {
+	return "wide16s"
+}
+
+func (w *Wide16) GetID() int64 {
+	return w.ID
+}
+
+func (w *Wide16) SetID(id int64) {
+	w.ID = id
+}
+
+func (w *Wide16) Columns() []string {
+	return wide16Columns
+}
+
+func (w *Wide16) Values() []interface{} {
+	return []interface{}{w.C01, w.C02, w.C03, w.C04, w.C05, w.C06, w.C07, w.C08, w.C09, w.C10, w.C11, w.C12, w.C13, w.C14, w.C15, w.C16}
+}
+
+func (w *Wide16) Pointers() []interface{} {
+	return []interface{}{&w.ID, &w.C01, &w.C02, &w.C03, &w.C04, &w.C05, &w.C06, &w.C07, &w.C08, &w.C09, &w.C10, &w.C11, &w.C12, &w.C13, &w.C14, &w.C15, &w.C16}
+}
+
+func (w *Wide16) Fill(seed int) {
+	w.C01 = fmt.Sprintf("wide%d_1", seed)
+	w.C02 = int64(seed) * 2
+	w.C03 = float64(seed) + 0.3
+	w.C04 = (seed+4)%2 == 0
+	w.C05 = wideBaseTime.Add(time.Duration(seed+5) * time.Second)
+	w.C06 = []byte(fmt.Sprintf("blob%d_6", seed))
+	w.C07 = fmt.Sprintf("wide%d_7", seed)
+	w.C08 = int64(seed) * 8
+	w.C09 = float64(seed) + 0.9
+	w.C10 = (seed+10)%2 == 0
+	w.C11 = wideBaseTime.Add(time.Duration(seed+11) * time.Second)
+	w.C12 = []byte(fmt.Sprintf("blob%d_12", seed))
+	w.C13 = fmt.Sprintf("wide%d_13", seed)
+	w.C14 = int64(seed) * 14
+	w.C15 = float64(seed) + 0.15
+	w.C16 = (seed+16)%2 == 0
+}
+
+// Wide32 32 列宽表模型
+type Wide32 struct {
+	ID  int64     `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	C01 string    `gorm:"column:c01" xorm:"varchar(100) 'c01'" json:"c01" zorm:"c01" borm:"c01" bun:"c01"`
+	C02 int64     `gorm:"column:c02" xorm:"bigint 'c02'" json:"c02" zorm:"c02" borm:"c02" bun:"c02"`
+	C03 float64   `gorm:"column:c03" xorm:"double 'c03'" json:"c03" zorm:"c03" borm:"c03" bun:"c03"`
+	C04 bool      `gorm:"column:c04" xorm:"bool 'c04'" json:"c04" zorm:"c04" borm:"c04" bun:"c04"`
+	C05 time.Time `gorm:"column:c05" xorm:"datetime 'c05'" json:"c05" zorm:"c05" borm:"c05" bun:"c05"`
+	C06 []byte    `gorm:"column:c06" xorm:"blob 'c06'" json:"c06" zorm:"c06" borm:"c06" bun:"c06"`
+	C07 string    `gorm:"column:c07" xorm:"varchar(100) 'c07'" json:"c07" zorm:"c07" borm:"c07" bun:"c07"`
+	C08 int64     `gorm:"column:c08" xorm:"bigint 'c08'" json:"c08" zorm:"c08" borm:"c08" bun:"c08"`
+	C09 float64   `gorm:"column:c09" xorm:"double 'c09'" json:"c09" zorm:"c09" borm:"c09" bun:"c09"`
+	C10 bool      `gorm:"column:c10" xorm:"bool 'c10'" json:"c10" zorm:"c10" borm:"c10" bun:"c10"`
+	C11 time.Time `gorm:"column:c11" xorm:"datetime 'c11'" json:"c11" zorm:"c11" borm:"c11" bun:"c11"`
+	C12 []byte    `gorm:"column:c12" xorm:"blob 'c12'" json:"c12" zorm:"c12" borm:"c12" bun:"c12"`
+	C13 string    `gorm:"column:c13" xorm:"varchar(100) 'c13'" json:"c13" zorm:"c13" borm:"c13" bun:"c13"`
+	C14 int64     `gorm:"column:c14" xorm:"bigint 'c14'" json:"c14" zorm:"c14" borm:"c14" bun:"c14"`
+	C15 float64   `gorm:"column:c15" xorm:"double 'c15'" json:"c15" zorm:"c15" borm:"c15" bun:"c15"`
+	C16 bool      `gorm:"column:c16" xorm:"bool 'c16'" json:"c16" zorm:"c16" borm:"c16" bun:"c16"`
+	C17 time.Time `gorm:"column:c17" xorm:"datetime 'c17'" json:"c17" zorm:"c17" borm:"c17" bun:"c17"`
+	C18 []byte    `gorm:"column:c18" xorm:"blob 'c18'" json:"c18" zorm:"c18" borm:"c18" bun:"c18"`
+	C19 string    `gorm:"column:c19" xorm:"varchar(100) 'c19'" json:"c19" zorm:"c19" borm:"c19" bun:"c19"`
+	C20 int64     `gorm:"column:c20" xorm:"bigint 'c20'" json:"c20" zorm:"c20" borm:"c20" bun:"c20"`
+	C21 float64   `gorm:"column:c21" xorm:"double 'c21'" json:"c21" zorm:"c21" borm:"c21" bun:"c21"`
+	C22 bool      `gorm:"column:c22" xorm:"bool 'c22'" json:"c22" zorm:"c22" borm:"c22" bun:"c22"`
+	C23 time.Time `gorm:"column:c23" xorm:"datetime 'c23'" json:"c23" zorm:"c23" borm:"c23" bun:"c23"`
+	C24 []byte    `gorm:"column:c24" xorm:"blob 'c24'" json:"c24" zorm:"c24" borm:"c24" bun:"c24"`
+	C25 string    `gorm:"column:c25" xorm:"varchar(100) 'c25'" json:"c25" zorm:"c25" borm:"c25" bun:"c25"`
+	C26 int64     `gorm:"column:c26" xorm:"bigint 'c26'" json:"c26" zorm:"c26" borm:"c26" bun:"c26"`
+	C27 float64   `gorm:"column:c27" xorm:"double 'c27'" json:"c27" zorm:"c27" borm:"c27" bun:"c27"`
+	C28 bool      `gorm:"column:c28" xorm:"bool 'c28'" json:"c28" zorm:"c28" borm:"c28" bun:"c28"`
+	C29 time.Time `gorm:"column:c29" xorm:"datetime 'c29'" json:"c29" zorm:"c29" borm:"c29" bun:"c29"`
+	C30 []byte    `gorm:"column:c30" xorm:"blob 'c30'" json:"c30" zorm:"c30" borm:"c30" bun:"c30"`
+	C31 string    `gorm:"column:c31" xorm:"varchar(100) 'c31'" json:"c31" zorm:"c31" borm:"c31" bun:"c31"`
+	C32 int64     `gorm:"column:c32" xorm:"bigint 'c32'" json:"c32" zorm:"c32" borm:"c32" bun:"c32"`
+}
+
+// TableName 表名
+func (Wide32) TableName() string {
+	return "wide32s"
+}
+
+func (w *Wide32) GetID() int64 {
+	return w.ID
+}
+
+func (w *Wide32) SetID(id int64) {
+	w.ID = id
+}
+
+func (w *Wide32) Columns() []string {
+	return wide32Columns
+}
+
+func (w *Wide32) Values() []interface{} {
+	return []interface{}{w.C01, w.C02, w.C03, w.C04, w.C05, w.C06, w.C07, w.C08, w.C09, w.C10, w.C11, w.C12, w.C13, w.C14, w.C15, w.C16, w.C17, w.C18, w.C19, w.C20, w.C21, w.C22, w.C23, w.C24, w.C25, w.C26, w.C27, w.C28, w.C29, w.C30, w.C31, w.C32}
+}
+
+func (w *Wide32) Pointers() []interface{} {
+	return []interface{}{&w.ID, &w.C01, &w.C02, &w.C03, &w.C04, &w.C05, &w.C06, &w.C07, &w.C08, &w.C09, &w.C10, &w.C11, &w.C12, &w.C13, &w.C14, &w.C15, &w.C16, &w.C17, &w.C18, &w.C19, &w.C20, &w.C21, &w.C22, &w.C23, &w.C24, &w.C25, &w.C26, &w.C27, &w.C28, &w.C29, &w.C30, &w.C31, &w.C32}
+}
+
+func (w *Wide32) Fill(seed int) {
+	w.C01 = fmt.Sprintf("wide%d_1", seed)
+	w.C02 = int64(seed) * 2
+	w.C03 = float64(seed) + 0.3
+	w.C04 = (seed+4)%2 == 0
+	w.C05 = wideBaseTime.Add(time.Duration(seed+5) * time.Second)
+	w.C06 = []byte(fmt.Sprintf("blob%d_6", seed))
+	w.C07 = fmt.Sprintf("wide%d_7", seed)
+	w.C08 = int64(seed) * 8
+	w.C09 = float64(seed) + 0.9
+	w.C10 = (seed+10)%2 == 0
+	w.C11 = wideBaseTime.Add(time.Duration(seed+11) * time.Second)
+	w.C12 = []byte(fmt.Sprintf("blob%d_12", seed))
+	w.C13 = fmt.Sprintf("wide%d_13", seed)
+	w.C14 = int64(seed) * 14
+	w.C15 = float64(seed) + 0.15
+	w.C16 = (seed+16)%2 == 0
+	w.C17 = wideBaseTime.Add(time.Duration(seed+17) * time.Second)
+	w.C18 = []byte(fmt.Sprintf("blob%d_18", seed))
+	w.C19 = fmt.Sprintf("wide%d_19", seed)
+	w.C20 = int64(seed) * 20
+	w.C21 = float64(seed) + 0.21
+	w.C22 = (seed+22)%2 == 0
+	w.C23 = wideBaseTime.Add(time.Duration(seed+23) * time.Second)
+	w.C24 = []byte(fmt.Sprintf("blob%d_24", seed))
+	w.C25 = fmt.Sprintf("wide%d_25", seed)
+	w.C26 = int64(seed) * 26
+	w.C27 = float64(seed) + 0.27
+	w.C28 = (seed+28)%2 == 0
+	w.C29 = wideBaseTime.Add(time.Duration(seed+29) * time.Second)
+	w.C30 = []byte(fmt.Sprintf("blob%d_30", seed))
+	w.C31 = fmt.Sprintf("wide%d_31", seed)
+	w.C32 = int64(seed) * 32
+}
+
+// Wide64 64 列宽表模型
+type Wide64 struct {
+	ID  int64     `gorm:"primaryKey" xorm:"pk autoincr 'id'" json:"id" zorm:"id,auto_incr" borm:"id" bun:"id,pk,autoincrement"`
+	C01 string    `gorm:"column:c01" xorm:"varchar(100) 'c01'" json:"c01" zorm:"c01" borm:"c01" bun:"c01"`
+	C02 int64     `gorm:"column:c02" xorm:"bigint 'c02'" json:"c02" zorm:"c02" borm:"c02" bun:"c02"`
+	C03 float64   `gorm:"column:c03" xorm:"double 'c03'" json:"c03" zorm:"c03" borm:"c03" bun:"c03"`
+	C04 bool      `gorm:"column:c04" xorm:"bool 'c04'" json:"c04" zorm:"c04" borm:"c04" bun:"c04"`
+	C05 time.Time `gorm:"column:c05" xorm:"datetime 'c05'" json:"c05" zorm:"c05" borm:"c05" bun:"c05"`
+	C06 []byte    `gorm:"column:c06" xorm:"blob 'c06'" json:"c06" zorm:"c06" borm:"c06" bun:"c06"`
+	C07 string    `gorm:"column:c07" xorm:"varchar(100) 'c07'" json:"c07" zorm:"c07" borm:"c07" bun:"c07"`
+	C08 int64     `gorm:"column:c08" xorm:"bigint 'c08'" json:"c08" zorm:"c08" borm:"c08" bun:"c08"`
+	C09 float64   `gorm:"column:c09" xorm:"double 'c09'" json:"c09" zorm:"c09" borm:"c09" bun:"c09"`
+	C10 bool      `gorm:"column:c10" xorm:"bool 'c10'" json:"c10" zorm:"c10" borm:"c10" bun:"c10"`
+	C11 time.Time `gorm:"column:c11" xorm:"datetime 'c11'" json:"c11" zorm:"c11" borm:"c11" bun:"c11"`
+	C12 []byte    `gorm:"column:c12" xorm:"blob 'c12'" json:"c12" zorm:"c12" borm:"c12" bun:"c12"`
+	C13 string    `gorm:"column:c13" xorm:"varchar(100) 'c13'" json:"c13" zorm:"c13" borm:"c13" bun:"c13"`
+	C14 int64     `gorm:"column:c14" xorm:"bigint 'c14'" json:"c14" zorm:"c14" borm:"c14" bun:"c14"`
+	C15 float64   `gorm:"column:c15" xorm:"double 'c15'" json:"c15" zorm:"c15" borm:"c15" bun:"c15"`
+	C16 bool      `gorm:"column:c16" xorm:"bool 'c16'" json:"c16" zorm:"c16" borm:"c16" bun:"c16"`
+	C17 time.Time `gorm:"column:c17" xorm:"datetime 'c17'" json:"c17" zorm:"c17" borm:"c17" bun:"c17"`
+	C18 []byte    `gorm:"column:c18" xorm:"blob 'c18'" json:"c18" zorm:"c18" borm:"c18" bun:"c18"`
+	C19 string    `gorm:"column:c19" xorm:"varchar(100) 'c19'" json:"c19" zorm:"c19" borm:"c19" bun:"c19"`
+	C20 int64     `gorm:"column:c20" xorm:"bigint 'c20'" json:"c20" zorm:"c20" borm:"c20" bun:"c20"`
+	C21 float64   `gorm:"column:c21" xorm:"double 'c21'" json:"c21" zorm:"c21" borm:"c21" bun:"c21"`
+	C22 bool      `gorm:"column:c22" xorm:"bool 'c22'" json:"c22" zorm:"c22" borm:"c22" bun:"c22"`
+	C23 time.Time `gorm:"column:c23" xorm:"datetime 'c23'" json:"c23" zorm:"c23" borm:"c23" bun:"c23"`
+	C24 []byte    `gorm:"column:c24" xorm:"blob 'c24'" json:"c24" zorm:"c24" borm:"c24" bun:"c24"`
+	C25 string    `gorm:"column:c25" xorm:"varchar(100) 'c25'" json:"c25" zorm:"c25" borm:"c25" bun:"c25"`
+	C26 int64     `gorm:"column:c26" xorm:"bigint 'c26'" json:"c26" zorm:"c26" borm:"c26" bun:"c26"`
+	C27 float64   `gorm:"column:c27" xorm:"double 'c27'" json:"c27" zorm:"c27" borm:"c27" bun:"c27"`
+	C28 bool      `gorm:"column:c28" xorm:"bool 'c28'" json:"c28" zorm:"c28" borm:"c28" bun:"c28"`
+	C29 time.Time `gorm:"column:c29" xorm:"datetime 'c29'" json:"c29" zorm:"c29" borm:"c29" bun:"c29"`
+	C30 []byte    `gorm:"column:c30" xorm:"blob 'c30'" json:"c30" zorm:"c30" borm:"c30" bun:"c30"`
+	C31 string    `gorm:"column:c31" xorm:"varchar(100) 'c31'" json:"c31" zorm:"c31" borm:"c31" bun:"c31"`
+	C32 int64     `gorm:"column:c32" xorm:"bigint 'c32'" json:"c32" zorm:"c32" borm:"c32" bun:"c32"`
+	C33 float64   `gorm:"column:c33" xorm:"double 'c33'" json:"c33" zorm:"c33" borm:"c33" bun:"c33"`
+	C34 bool      `gorm:"column:c34" xorm:"bool 'c34'" json:"c34" zorm:"c34" borm:"c34" bun:"c34"`
+	C35 time.Time `gorm:"column:c35" xorm:"datetime 'c35'" json:"c35" zorm:"c35" borm:"c35" bun:"c35"`
+	C36 []byte    `gorm:"column:c36" xorm:"blob 'c36'" json:"c36" zorm:"c36" borm:"c36" bun:"c36"`
+	C37 string    `gorm:"column:c37" xorm:"varchar(100) 'c37'" json:"c37" zorm:"c37" borm:"c37" bun:"c37"`
+	C38 int64     `gorm:"column:c38" xorm:"bigint 'c38'" json:"c38" zorm:"c38" borm:"c38" bun:"c38"`
+	C39 float64   `gorm:"column:c39" xorm:"double 'c39'" json:"c39" zorm:"c39" borm:"c39" bun:"c39"`
+	C40 bool      `gorm:"column:c40" xorm:"bool 'c40'" json:"c40" zorm:"c40" borm:"c40" bun:"c40"`
+	C41 time.Time `gorm:"column:c41" xorm:"datetime 'c41'" json:"c41" zorm:"c41" borm:"c41" bun:"c41"`
+	C42 []byte    `gorm:"column:c42" xorm:"blob 'c42'" json:"c42" zorm:"c42" borm:"c42" bun:"c42"`
+	C43 string    `gorm:"column:c43" xorm:"varchar(100) 'c43'" json:"c43" zorm:"c43" borm:"c43" bun:"c43"`
+	C44 int64     `gorm:"column:c44" xorm:"bigint 'c44'" json:"c44" zorm:"c44" borm:"c44" bun:"c44"`
+	C45 float64   `gorm:"column:c45" xorm:"double 'c45'" json:"c45" zorm:"c45" borm:"c45" bun:"c45"`
+	C46 bool      `gorm:"column:c46" xorm:"bool 'c46'" json:"c46" zorm:"c46" borm:"c46" bun:"c46"`
+	C47 time.Time `gorm:"column:c47" xorm:"datetime 'c47'" json:"c47" zorm:"c47" borm:"c47" bun:"c47"`
+	C48 []byte    `gorm:"column:c48" xorm:"blob 'c48'" json:"c48" zorm:"c48" borm:"c48" bun:"c48"`
+	C49 string    `gorm:"column:c49" xorm:"varchar(100) 'c49'" json:"c49" zorm:"c49" borm:"c49" bun:"c49"`
+	C50 int64     `gorm:"column:c50" xorm:"bigint 'c50'" json:"c50" zorm:"c50" borm:"c50" bun:"c50"`
+	C51 float64   `gorm:"column:c51" xorm:"double 'c51'" json:"c51" zorm:"c51" borm:"c51" bun:"c51"`
+	C52 bool      `gorm:"column:c52" xorm:"bool 'c52'" json:"c52" zorm:"c52" borm:"c52" bun:"c52"`
+	C53 time.Time `gorm:"column:c53" xorm:"datetime 'c53'" json:"c53" zorm:"c53" borm:"c53" bun:"c53"`
+	C54 []byte    `gorm:"column:c54" xorm:"blob 'c54'" json:"c54" zorm:"c54" borm:"c54" bun:"c54"`
+	C55 string    `gorm:"column:c55" xorm:"varchar(100) 'c55'" json:"c55" zorm:"c55" borm:"c55" bun:"c55"`
+	C56 int64     `gorm:"column:c56" xorm:"bigint 'c56'" json:"c56" zorm:"c56" borm:"c56" bun:"c56"`
+	C57 float64   `gorm:"column:c57" xorm:"double 'c57'" json:"c57" zorm:"c57" borm:"c57" bun:"c57"`
+	C58 bool      `gorm:"column:c58" xorm:"bool 'c58'" json:"c58" zorm:"c58" borm:"c58" bun:"c58"`
+	C59 time.Time `gorm:"column:c59" xorm:"datetime 'c59'" json:"c59" zorm:"c59" borm:"c59" bun:"c59"`
+	C60 []byte    `gorm:"column:c60" xorm:"blob 'c60'" json:"c60" zorm:"c60" borm:"c60" bun:"c60"`
+	C61 string    `gorm:"column:c61" xorm:"varchar(100) 'c61'" json:"c61" zorm:"c61" borm:"c61" bun:"c61"`
+	C62 int64     `gorm:"column:c62" xorm:"bigint 'c62'" json:"c62" zorm:"c62" borm:"c62" bun:"c62"`
+	C63 float64   `gorm:"column:c63" xorm:"double 'c63'" json:"c63" zorm:"c63" borm:"c63" bun:"c63"`
+	C64 bool      `gorm:"column:c64" xorm:"bool 'c64'" json:"c64" zorm:"c64" borm:"c64" bun:"c64"`
+}
+
+// TableName 表名
+func (Wide64) TableName() string {
+	return "wide64s"
+}
+
+func (w *Wide64) GetID() int64 {
+	return w.ID
+}
+
+func (w *Wide64) SetID(id int64) {
+	w.ID = id
+}
+
+func (w *Wide64) Columns() []string {
+	return wide64Columns
+}
+
+func (w *Wide64) Values() []interface{} {
+	return []interface{}{w.C01, w.C02, w.C03, w.C04, w.C05, w.C06, w.C07, w.C08, w.C09, w.C10, w.C11, w.C12, w.C13, w.C14, w.C15, w.C16, w.C17, w.C18, w.C19, w.C20, w.C21, w.C22, w.C23, w.C24, w.C25, w.C26, w.C27, w.C28, w.C29, w.C30, w.C31, w.C32, w.C33, w.C34, w.C35, w.C36, w.C37, w.C38, w.C39, w.C40, w.C41, w.C42, w.C43, w.C44, w.C45, w.C46, w.C47, w.C48, w.C49, w.C50, w.C51, w.C52, w.C53, w.C54, w.C55, w.C56, w.C57, w.C58, w.C59, w.C60, w.C61, w.C62, w.C63, w.C64}
+}
+
+func (w *Wide64) Pointers() []interface{} {
+	return []interface{}{&w.ID, &w.C01, &w.C02, &w.C03, &w.C04, &w.C05, &w.C06, &w.C07, &w.C08, &w.C09, &w.C10, &w.C11, &w.C12, &w.C13, &w.C14, &w.C15, &w.C16, &w.C17, &w.C18, &w.C19, &w.C20, &w.C21, &w.C22, &w.C23, &w.C24, &w.C25, &w.C26, &w.C27, &w.C28, &w.C29, &w.C30, &w.C31, &w.C32, &w.C33, &w.C34, &w.C35, &w.C36, &w.C37, &w.C38, &w.C39, &w.C40, &w.C41, &w.C42, &w.C43, &w.C44, &w.C45, &w.C46, &w.C47, &w.C48, &w.C49, &w.C50, &w.C51, &w.C52, &w.C53, &w.C54, &w.C55, &w.C56, &w.C57, &w.C58, &w.C59, &w.C60, &w.C61, &w.C62, &w.C63, &w.C64}
+}
+
+func (w *Wide64) Fill(seed int) {
+	w.C01 = fmt.Sprintf("wide%d_1", seed)
+	w.C02 = int64(seed) * 2
+	w.C03 = float64(seed) + 0.3
+	w.C04 = (seed+4)%2 == 0
+	w.C05 = wideBaseTime.Add(time.Duration(seed+5) * time.Second)
+	w.C06 = []byte(fmt.Sprintf("blob%d_6", seed))
+	w.C07 = fmt.Sprintf("wide%d_7", seed)
+	w.C08 = int64(seed) * 8
+	w.C09 = float64(seed) + 0.9
+	w.C10 = (seed+10)%2 == 0
+	w.C11 = wideBaseTime.Add(time.Duration(seed+11) * time.Second)
+	w.C12 = []byte(fmt.Sprintf("blob%d_12", seed))
+	w.C13 = fmt.Sprintf("wide%d_13", seed)
+	w.C14 = int64(seed) * 14
+	w.C15 = float64(seed) + 0.15
+	w.C16 = (seed+16)%2 == 0
+	w.C17 = wideBaseTime.Add(time.Duration(seed+17) * time.Second)
+	w.C18 = []byte(fmt.Sprintf("blob%d_18", seed))
+	w.C19 = fmt.Sprintf("wide%d_19", seed)
+	w.C20 = int64(seed) * 20
+	w.C21 = float64(seed) + 0.21
+	w.C22 = (seed+22)%2 == 0
+	w.C23 = wideBaseTime.Add(time.Duration(seed+23) * time.Second)
+	w.C24 = []byte(fmt.Sprintf("blob%d_24", seed))
+	w.C25 = fmt.Sprintf("wide%d_25", seed)
+	w.C26 = int64(seed) * 26
+	w.C27 = float64(seed) + 0.27
+	w.C28 = (seed+28)%2 == 0
+	w.C29 = wideBaseTime.Add(time.Duration(seed+29) * time.Second)
+	w.C30 = []byte(fmt.Sprintf("blob%d_30", seed))
+	w.C31 = fmt.Sprintf("wide%d_31", seed)
+	w.C32 = int64(seed) * 32
+	w.C33 = float64(seed) + 0.33
+	w.C34 = (seed+34)%2 == 0
+	w.C35 = wideBaseTime.Add(time.Duration(seed+35) * time.Second)
+	w.C36 = []byte(fmt.Sprintf("blob%d_36", seed))
+	w.C37 = fmt.Sprintf("wide%d_37", seed)
+	w.C38 = int64(seed) * 38
+	w.C39 = float64(seed) + 0.39
+	w.C40 = (seed+40)%2 == 0
+	w.C41 = wideBaseTime.Add(time.Duration(seed+41) * time.Second)
+	w.C42 = []byte(fmt.Sprintf("blob%d_42", seed))
+	w.C43 = fmt.Sprintf("wide%d_43", seed)
+	w.C44 = int64(seed) * 44
+	w.C45 = float64(seed) + 0.45
+	w.C46 = (seed+46)%2 == 0
+	w.C47 = wideBaseTime.Add(time.Duration(seed+47) * time.Second)
+	w.C48 = []byte(fmt.Sprintf("blob%d_48", seed))
+	w.C49 = fmt.Sprintf("wide%d_49", seed)
+	w.C50 = int64(seed) * 50
+	w.C51 = float64(seed) + 0.51
+	w.C52 = (seed+52)%2 == 0
+	w.C53 = wideBaseTime.Add(time.Duration(seed+53) * time.Second)
+	w.C54 = []byte(fmt.Sprintf("blob%d_54", seed))
+	w.C55 = fmt.Sprintf("wide%d_55", seed)
+	w.C56 = int64(seed) * 56
+	w.C57 = float64(seed) + 0.57
+	w.C58 = (seed+58)%2 == 0
+	w.C59 = wideBaseTime.Add(time.Duration(seed+59) * time.Second)
+	w.C60 = []byte(fmt.Sprintf("blob%d_60", seed))
+	w.C61 = fmt.Sprintf("wide%d_61", seed)
+	w.C62 = int64(seed) * 62
+	w.C63 = float64(seed) + 0.63
+	w.C64 = (seed+64)%2 == 0
+}
+
+var wide8Columns = []string{"c01", "c02", "c03", "c04", "c05", "c06", "c07", "c08"}
+
+var wide16Columns = []string{"c01", "c02", "c03", "c04", "c05", "c06", "c07", "c08", "c09", "c10", "c11", "c12", "c13", "c14", "c15", "c16"}
+
+var wide32Columns = []string{"c01", "c02", "c03", "c04", "c05", "c06", "c07", "c08", "c09", "c10", "c11", "c12", "c13", "c14", "c15", "c16", "c17", "c18", "c19", "c20", "c21", "c22", "c23", "c24", "c25", "c26", "c27", "c28", "c29", "c30", "c31", "c32"}
+
+var wide64Columns = []string{"c01", "c02", "c03", "c04", "c05", "c06", "c07", "c08", "c09", "c10", "c11", "c12", "c13", "c14", "c15", "c16", "c17", "c18", "c19", "c20", "c21", "c22", "c23", "c24", "c25", "c26", "c27", "c28", "c29", "c30", "c31", "c32", "c33", "c34", "c35", "c36", "c37", "c38", "c39", "c40", "c41", "c42", "c43", "c44", "c45", "c46", "c47", "c48", "c49", "c50", "c51", "c52", "c53", "c54", "c55", "c56", "c57", "c58", "c59", "c60", "c61", "c62", "c63", "c64"}
+
+// NewWide 创建指定列数的宽表模型，列数不在 WideWidths 中时返回 nil
+func NewWide(cols int) Wide {
+	switch cols {
+	case 8:
+		return &Wide8{}
+	case 16:
+		return &Wide16{}
+	case 32:
+		return &Wide32{}
+	case 64:
+		return &Wide64{}
+	}
+	return nil
+}
+
+// NewWideSlice 创建指定列数的宽表切片指针（如 *[]*Wide8），供 ORM 查询填充
+func NewWideSlice(cols int) interface{} {
+	switch cols {
+	case 8:
+		return &[]*Wide8{}
+	case 16:
+		return &[]*Wide16{}
+	case 32:
+		return &[]*Wide32{}
+	case 64:
+		return &[]*Wide64{}
+	}
+	return nil
+}
+
+// WideRows 将 NewWideSlice 返回的切片指针转换为 []Wide
+func WideRows(slice interface{}) []Wide {
+	var rows []Wide
+	switch s := slice.(type) {
+	case *[]*Wide8:
+		rows = make([]Wide, len(*s))
+		for i, w := range *s {
+			rows[i] = w
+		}
+	case *[]*Wide16:
+		rows = make([]Wide, len(*s))
+		for i, w := range *s {
+			rows[i] = w
+		}
+	case *[]*Wide32:
+		rows = make([]Wide, len(*s))
+		for i, w := range *s {
+			rows[i] = w
+		}
+	case *[]*Wide64:
+		rows = make([]Wide, len(*s))
+		for i, w := range *s {
+			rows[i] = w
+		}
+	}
+	return rows
+}
+
+// WideDDL 返回宽表的建表语句
+func WideDDL(cols int) string {
+	switch cols {
+	case 8:
+		return `CREATE TABLE IF NOT EXISTS wide8s (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	c01 VARCHAR(100) NOT NULL,
+	c02 INTEGER NOT NULL,
+	c03 REAL NOT NULL,
+	c04 BOOLEAN NOT NULL,
+	c05 DATETIME NOT NULL,
+	c06 BLOB NOT NULL,
+	c07 VARCHAR(100) NOT NULL,
+	c08 INTEGER NOT NULL
+)`
+	case 16:
+		return `CREATE TABLE IF NOT EXISTS wide16s (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	c01 VARCHAR(100) NOT NULL,
+	c02 INTEGER NOT NULL,
+	c03 REAL NOT NULL,
+	c04 BOOLEAN NOT NULL,
+	c05 DATETIME NOT NULL,
+	c06 BLOB NOT NULL,
+	c07 VARCHAR(100) NOT NULL,
+	c08 INTEGER NOT NULL,
+	c09 REAL NOT NULL,
+	c10 BOOLEAN NOT NULL,
+	c11 DATETIME NOT NULL,
+	c12 BLOB NOT NULL,
+	c13 VARCHAR(100) NOT NULL,
+	c14 INTEGER NOT NULL,
+	c15 REAL NOT NULL,
+	c16 BOOLEAN NOT NULL
+)`
+	case 32:
+		return `CREATE TABLE IF NOT EXISTS wide32s (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	c01 VARCHAR(100) NOT NULL,
+	c02 INTEGER NOT NULL,
+	c03 REAL NOT NULL,
+	c04 BOOLEAN NOT NULL,
+	c05 DATETIME NOT NULL,
+	c06 BLOB NOT NULL,
+	c07 VARCHAR(100) NOT NULL,
+	c08 INTEGER NOT NULL,
+	c09 REAL NOT NULL,
+	c10 BOOLEAN NOT NULL,
+	c11 DATETIME NOT NULL,
+	c12 BLOB NOT NULL,
+	c13 VARCHAR(100) NOT NULL,
+	c14 INTEGER NOT NULL,
+	c15 REAL NOT NULL,
+	c16 BOOLEAN NOT NULL,
+	c17 DATETIME NOT NULL,
+	c18 BLOB NOT NULL,
+	c19 VARCHAR(100) NOT NULL,
+	c20 INTEGER NOT NULL,
+	c21 REAL NOT NULL,
+	c22 BOOLEAN NOT NULL,
+	c23 DATETIME NOT NULL,
+	c24 BLOB NOT NULL,
+	c25 VARCHAR(100) NOT NULL,
+	c26 INTEGER NOT NULL,
+	c27 REAL NOT NULL,
+	c28 BOOLEAN NOT NULL,
+	c29 DATETIME NOT NULL,
+	c30 BLOB NOT NULL,
+	c31 VARCHAR(100) NOT NULL,
+	c32 INTEGER NOT NULL
+)`
+	case 64:
+		return `CREATE TABLE IF NOT EXISTS wide64s (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	c01 VARCHAR(100) NOT NULL,
+	c02 INTEGER NOT NULL,
+	c03 REAL NOT NULL,
+	c04 BOOLEAN NOT NULL,
+	c05 DATETIME NOT NULL,
+	c06 BLOB NOT NULL,
+	c07 VARCHAR(100) NOT NULL,
+	c08 INTEGER NOT NULL,
+	c09 REAL NOT NULL,
+	c10 BOOLEAN NOT NULL,
+	c11 DATETIME NOT NULL,
+	c12 BLOB NOT NULL,
+	c13 VARCHAR(100) NOT NULL,
+	c14 INTEGER NOT NULL,
+	c15 REAL NOT NULL,
+	c16 BOOLEAN NOT NULL,
+	c17 DATETIME NOT NULL,
+	c18 BLOB NOT NULL,
+	c19 VARCHAR(100) NOT NULL,
+	c20 INTEGER NOT NULL,
+	c21 REAL NOT NULL,
+	c22 BOOLEAN NOT NULL,
+	c23 DATETIME NOT NULL,
+	c24 BLOB NOT NULL,
+	c25 VARCHAR(100) NOT NULL,
+	c26 INTEGER NOT NULL,
+	c27 REAL NOT NULL,
+	c28 BOOLEAN NOT NULL,
+	c29 DATETIME NOT NULL,
+	c30 BLOB NOT NULL,
+	c31 VARCHAR(100) NOT NULL,
+	c32 INTEGER NOT NULL,
+	c33 REAL NOT NULL,
+	c34 BOOLEAN NOT NULL,
+	c35 DATETIME NOT NULL,
+	c36 BLOB NOT NULL,
+	c37 VARCHAR(100) NOT NULL,
+	c38 INTEGER NOT NULL,
+	c39 REAL NOT NULL,
+	c40 BOOLEAN NOT NULL,
+	c41 DATETIME NOT NULL,
+	c42 BLOB NOT NULL,
+	c43 VARCHAR(100) NOT NULL,
+	c44 INTEGER NOT NULL,
+	c45 REAL NOT NULL,
+	c46 BOOLEAN NOT NULL,
+	c47 DATETIME NOT NULL,
+	c48 BLOB NOT NULL,
+	c49 VARCHAR(100) NOT NULL,
+	c50 INTEGER NOT NULL,
+	c51 REAL NOT NULL,
+	c52 BOOLEAN NOT NULL,
+	c53 DATETIME NOT NULL,
+	c54 BLOB NOT NULL,
+	c55 VARCHAR(100) NOT NULL,
+	c56 INTEGER NOT NULL,
+	c57 REAL NOT NULL,
+	c58 BOOLEAN NOT NULL,
+	c59 DATETIME NOT NULL,
+	c60 BLOB NOT NULL,
+	c61 VARCHAR(100) NOT NULL,
+	c62 INTEGER NOT NULL,
+	c63 REAL NOT NULL,
+	c64 BOOLEAN NOT NULL
+)`
+	}
+	return ""
+}
+
+// WideInsertSQL 返回宽表的单行插入语句
+func WideInsertSQL(cols int) string {
+	switch cols {
+	case 8:
+		return "INSERT INTO wide8s (c01, c02, c03, c04, c05, c06, c07, c08) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	case 16:
+		return "INSERT INTO wide16s (c01, c02, c03, c04, c05, c06, c07, c08, c09, c10, c11, c12, c13, c14, c15, c16) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	case 32:
+		return "INSERT INTO wide32s (c01, c02, c03, c04, c05, c06, c07, c08, c09, c10, c11, c12, c13, c14, c15, c16, c17, c18, c19, c20, c21, c22, c23, c24, c25, c26, c27, c28, c29, c30, c31, c32) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	case 64:
+		return "INSERT INTO wide64s (c01, c02, c03, c04, c05, c06, c07, c08, c09, c10, c11, c12, c13, c14, c15, c16, c17, c18, c19, c20, c21, c22, c23, c24, c25, c26, c27, c28, c29, c30, c31, c32, c33, c34, c35, c36, c37, c38, c39, c40, c41, c42, c43, c44, c45, c46, c47, c48, c49, c50, c51, c52, c53, c54, c55, c56, c57, c58, c59, c60, c61, c62, c63, c64) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	}
+	return ""
+}
+
+// WideSelectSQL 返回宽表的分页查询语句（按 ID 排序，LIMIT ? OFFSET ?）
+func WideSelectSQL(cols int) string {
+	switch cols {
+	case 8:
+		return "SELECT id, c01, c02, c03, c04, c05, c06, c07, c08 FROM wide8s ORDER BY id LIMIT ? OFFSET ?"
+	case 16:
+		return "SELECT id, c01, c02, c03, c04, c05, c06, c07, c08, c09, c10, c11, c12, c13, c14, c15, c16 FROM wide16s ORDER BY id LIMIT ? OFFSET ?"
+	case 32:
+		return "SELECT id, c01, c02, c03, c04, c05, c06, c07, c08, c09, c10, c11, c12, c13, c14, c15, c16, c17, c18, c19, c20, c21, c22, c23, c24, c25, c26, c27, c28, c29, c30, c31, c32 FROM wide32s ORDER BY id LIMIT ? OFFSET ?"
+	case 64:
+		return "SELECT id, c01, c02, c03, c04, c05, c06, c07, c08, c09, c10, c11, c12, c13, c14, c15, c16, c17, c18, c19, c20, c21, c22, c23, c24, c25, c26, c27, c28, c29, c30, c31, c32, c33, c34, c35, c36, c37, c38, c39, c40, c41, c42, c43, c44, c45, c46, c47, c48, c49, c50, c51, c52, c53, c54, c55, c56, c57, c58, c59, c60, c61, c62, c63, c64 FROM wide64s ORDER BY id LIMIT ? OFFSET ?"
+	}
+	return ""
+}
@@ -1,8 +1,18 @@
 package orm
 
-import "github.com/benchplus/goorm/internal/models"
+import (
+	"errors"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
 
 // Interface 统一的 ORM 接口
+//
+// 并发约定：Init、CreateTable 之后到 DropTable、Close 之前，其余方法可被多个 goroutine
+// 在同一个实例上并发调用，实现需自行保护延迟初始化的状态；Init、CreateTable、DropTable、Close
+// 不与其他调用并发。可选接口（WideInterface 等）遵循同样的约定。
 type Interface interface {
 	// Init 初始化数据库连接
 	Init(dsn string) error
@@ -19,7 +29,7 @@ type Interface interface {
 	// Insert 插入单条记录
 	Insert(user *models.User) error
 
-	// InsertBatch 批量插入
+	// InsertBatch 批量插入，生成的 ID 回填到每个 user
 	InsertBatch(users []*models.User) error
 
 	// GetByID 根据 ID 查询
@@ -37,6 +47,225 @@ type Interface interface {
 	// Count 统计数量
 	Count() (int64, error)
 
-	// GetAll 获取所有记录
+	// GetAll 按 ID 升序分页获取记录
 	GetAll(limit, offset int) ([]*models.User, error)
+
+	// GetByCreatedRange 查询 created_at 在 [from, to) 内的记录，按 created_at 升序
+	GetByCreatedRange(from, to time.Time) ([]*models.User, error)
+
+	// RawQuery 通过原生 SQL 查询，结果映射到 User
+	RawQuery(query string, args ...interface{}) ([]*models.User, error)
+
+	// Iterate 逐行遍历所有记录，不在内存中保留整个结果集；fn 返回错误时停止遍历。
+	// 遍历期间连接被占用，fn 中不得再调用同一实例的方法
+	Iterate(fn func(*models.User) error) error
+}
+
+// WideInterface 宽表模型操作，用于列数扩展测试
+type WideInterface interface {
+	// CreateWideTables 创建所有宽表
+	CreateWideTables() error
+
+	// DropWideTables 删除所有宽表
+	DropWideTables() error
+
+	// InsertWide 插入单条宽表记录
+	InsertWide(row models.Wide) error
+
+	// GetWide 分页查询指定列数的宽表记录
+	GetWide(cols, limit, offset int) ([]models.Wide, error)
+}
+
+// NullableInterface 可空列模型操作，用于 NULL/零值往返测试
+type NullableInterface interface {
+	// CreateNullableTable 创建可空列表
+	CreateNullableTable() error
+
+	// DropNullableTable 删除可空列表
+	DropNullableTable() error
+
+	// InsertNullable 插入单条记录
+	InsertNullable(user *models.NullableUser) error
+
+	// UpdateNullable 更新记录，NULL 字段需写回 NULL
+	UpdateNullable(user *models.NullableUser) error
+
+	// GetNullableByID 根据 ID 查询
+	GetNullableByID(id int64) (*models.NullableUser, error)
+
+	// GetNullableAll 分页查询
+	GetNullableAll(limit, offset int) ([]*models.NullableUser, error)
+
+	// GetPlainAll 按 ID 升序分页查询同一张表，映射到非空类型，作为 GetNullableAll 的对照；
+	// 只用于没有 NULL 的行
+	GetPlainAll(limit, offset int) ([]*models.PlainUser, error)
+}
+
+// JSONInterface JSON 列模型操作
+type JSONInterface interface {
+	// CreateJSONTable 创建 JSON 列表
+	CreateJSONTable() error
+
+	// DropJSONTable 删除 JSON 列表
+	DropJSONTable() error
+
+	// InsertProfile 插入单条记录
+	InsertProfile(profile *models.Profile) error
+
+	// GetProfileByID 根据 ID 查询
+	GetProfileByID(id int64) (*models.Profile, error)
+
+	// GetProfilesByTheme 通过 json_extract(settings, '$.theme') 查询
+	GetProfilesByTheme(theme string) ([]*models.Profile, error)
+}
+
+// PostInterface 文章（posts.body 大字段）操作
+type PostInterface interface {
+	// CreatePostTable 创建 posts 表
+	CreatePostTable() error
+
+	// DropPostTable 删除 posts 表
+	DropPostTable() error
+
+	// InsertPost 插入单条记录
+	InsertPost(post *models.Post) error
+
+	// GetPostByID 根据 ID 查询
+	GetPostByID(id int64) (*models.Post, error)
+
+	// DeletePost 删除记录
+	DeletePost(id int64) error
+}
+
+// MigrateInterface 表结构迁移操作，用 ORM 自带的迁移工具演进已有数据的表
+type MigrateInterface interface {
+	// CreateMigrateTable 按初始结构 models.MigrateUser 创建 migrate_users 表
+	CreateMigrateTable() error
+
+	// DropMigrateTable 删除 migrate_users 表及迁移工具自身的记录
+	DropMigrateTable() error
+
+	// Migrate 把 migrate_users 从初始结构迁移到 step 完成后的结构
+	Migrate(step models.MigrateStep) error
+}
+
+// HookInterface 注册了生命周期钩子的用户模型操作，用于钩子开销测试。
+// 模型与 models.User 结构相同，表名为 models.HookTable；钩子只计数，不读写数据
+type HookInterface interface {
+	// CreateHookTable 创建 hook_users 表
+	CreateHookTable() error
+
+	// DropHookTable 删除 hook_users 表
+	DropHookTable() error
+
+	// InsertHooked 插入单条记录，触发插入前后的钩子
+	InsertHooked(user *models.User) error
+
+	// UpdateHooked 更新记录，触发更新前后的钩子
+	UpdateHooked(user *models.User) error
+
+	// GetHookedByID 根据 ID 查询，触发查询钩子
+	GetHookedByID(id int64) (*models.User, error)
+
+	// HookCalls 返回钩子被调用的累计次数。钩子定义在模型或 schema 上，拿不到所属实例，
+	// 计数按包统计，包含同一进程中该实现所有实例的调用；调用方应取前后差值
+	HookCalls() int64
+}
+
+// SoftDeleteInterface 软删除用户模型操作，使用各 ORM 自带的软删除机制，原生 SQL 实现手写 deleted_at 条件。
+// 表名为 models.SoftDeleteTable，列为 users 的列加可空的 deleted_at；查询、计数都排除已删除的记录
+type SoftDeleteInterface interface {
+	// CreateSoftUserTable 创建 soft_users 表
+	CreateSoftUserTable() error
+
+	// DropSoftUserTable 删除 soft_users 表
+	DropSoftUserTable() error
+
+	// InsertSoftUser 插入单条未删除的记录
+	InsertSoftUser(user *models.User) error
+
+	// DeleteSoftUser 软删除记录，只设置 deleted_at；记录已删除或不存在时不做任何修改
+	DeleteSoftUser(id int64) error
+
+	// GetSoftUserByID 根据 ID 查询，记录已删除时返回错误
+	GetSoftUserByID(id int64) (*models.User, error)
+
+	// CountSoftUsers 统计未删除的记录数
+	CountSoftUsers() (int64, error)
+
+	// GetSoftUsers 按 ID 升序分页查询未删除的记录
+	GetSoftUsers(limit, offset int) ([]*models.User, error)
+}
+
+// ErrVersionConflict UpdateIfVersion 要更新的记录不存在，或其版本号与期望的不一致
+var ErrVersionConflict = errors.New("version conflict")
+
+// VersionInterface 带版本号的用户模型操作，用于乐观锁测试。
+// 有乐观锁支持的 ORM 使用自带的机制，其余实现以 WHERE version = ? 条件更新
+type VersionInterface interface {
+	// CreateVersionedTable 创建 versioned_users 表
+	CreateVersionedTable() error
+
+	// DropVersionedTable 删除 versioned_users 表
+	DropVersionedTable() error
+
+	// InsertVersioned 插入单条记录，版本号置为 1
+	InsertVersioned(user *models.VersionedUser) error
+
+	// UpdateIfVersion 仅当记录的版本号为 expectedVersion 时更新 Name、Email、Age 并把版本号加一，
+	// 成功后 user.Version 为新的版本号；否则不修改记录，返回 ErrVersionConflict
+	UpdateIfVersion(user *models.VersionedUser, expectedVersion int64) error
+
+	// GetVersionedByID 根据 ID 查询
+	GetVersionedByID(id int64) (*models.VersionedUser, error)
+}
+
+// KeyInterface 客户端生成主键的用户模型操作：文本 UUID 主键（models.UUIDUser）和
+// snowflake 风格的 int64 主键（models.SnowflakeUser）。插入时使用调用方设置的 ID，不读取 LastInsertId
+type KeyInterface interface {
+	// CreateKeyTables 创建 uuid_users 和 snowflake_users 表
+	CreateKeyTables() error
+
+	// DropKeyTables 删除 uuid_users 和 snowflake_users 表
+	DropKeyTables() error
+
+	// InsertUUIDUser 插入单条记录
+	InsertUUIDUser(user *models.UUIDUser) error
+
+	// InsertUUIDBatch 批量插入
+	InsertUUIDBatch(users []*models.UUIDUser) error
+
+	// GetUUIDUserByID 根据 ID 查询
+	GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error)
+
+	// InsertSnowflakeUser 插入单条记录
+	InsertSnowflakeUser(user *models.SnowflakeUser) error
+
+	// InsertSnowflakeBatch 批量插入
+	InsertSnowflakeBatch(users []*models.SnowflakeUser) error
+
+	// GetSnowflakeUserByID 根据 ID 查询
+	GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error)
+}
+
+// TagInterface 用户（users 表）与标签（tags 表）经 user_tags 连接表的多对多关联操作。
+// 有多对多映射的 ORM 使用自带的关联（gorm many2many、bun m2m、ent 边），其余实现手写 JOIN 查询
+type TagInterface interface {
+	// CreateTagTables 创建 tags 和 user_tags 表，users 表由 CreateTable 创建
+	CreateTagTables() error
+
+	// DropTagTables 删除 tags 和 user_tags 表
+	DropTagTables() error
+
+	// InsertTags 批量插入标签
+	InsertTags(tags []*models.Tag) error
+
+	// AttachTags 为用户添加标签，已有的关联保持不变
+	AttachTags(userID int64, tagIDs []int64) error
+
+	// GetUsersWithTags 按 ID 升序分页查询用户，并加载各自的标签
+	GetUsersWithTags(limit, offset int) ([]*models.TaggedUser, error)
+
+	// GetUsersByTag 查询带有名为 name 的标签的用户，按 ID 升序
+	GetUsersByTag(name string) ([]*models.User, error)
 }
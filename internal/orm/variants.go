@@ -0,0 +1,14 @@
+package orm
+
+// Variants 各 ORM 配置变体及其与默认配置的区别，键为注册名（<orm>+<variant>）。
+// 测试中的注册表和报告都以此为准，新增变体时在这里补充说明并重新生成基准测试入口。
+var Variants = map[string]string{
+	"gorm+prepared":   "`gorm.Config{PrepareStmt: true}`: caches prepared statements per SQL text",
+	"gorm+notx":       "`gorm.Config{SkipDefaultTransaction: true}`: writes are no longer wrapped in an implicit transaction",
+	"gorm+tuned":      "`PrepareStmt: true` and `SkipDefaultTransaction: true` together",
+	"xorm+cache":      "`engine.SetDefaultCacher` with an in-memory LRU cacher (1000 elements) for ID lookups and finds; the pool allows 2 connections because xorm's cached lookups query again while the first rows are still open",
+	"bun+prepared":    "Insert, GetByID, Update, Delete, Count and GetAll run hand-written SQL on statements prepared with `bun.DB.Prepare`; rows are still mapped by bun's `ScanRow`/`ScanRows` (bun's query builder inlines arguments, so it cannot reuse prepared statements)",
+	"ent+prepared":    "the ent `dialect.Driver` is wrapped so every generated query runs on a cached `*sql.Stmt`",
+	"sqlx+prepared":   "Insert and Update use `PrepareNamed` statements, InsertBatch reuses the named statement inside its transaction, and GetByID, Delete, Count and GetAll use `Preparex` statements",
+	"stdlib+prepared": "each statement is prepared on first use and reused",
+}
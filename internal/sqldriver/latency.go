@@ -0,0 +1,99 @@
+package sqldriver
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DSN 中的延迟参数，由延迟驱动取出后再交给 sqlite3
+const (
+	latencyParam = "latency"
+	jitterParam  = "jitter"
+)
+
+// sleepSlack time.Sleep 可能多睡的时间，首次使用时测量。
+// 不同系统的定时器精度从几十微秒到 1ms 不等，等待的最后这段时间改为忙等
+var sleepSlack = sync.OnceValue(func() time.Duration {
+	var slack time.Duration
+	for i := 0; i < 5; i++ {
+		start := time.Now()
+		time.Sleep(time.Microsecond)
+		slack = max(slack, time.Since(start))
+	}
+	return slack
+})
+
+// latency 每次往返注入的延迟：base 上下浮动不超过 jitter 的均匀分布
+type latency struct {
+	base   time.Duration
+	jitter time.Duration
+}
+
+// wait 模拟一次网络往返。行数据视为随响应一次返回，Rows.Next 不再等待
+func (l latency) wait() {
+	d := l.base
+	if l.jitter > 0 {
+		d += time.Duration(rand.Int64N(int64(2*l.jitter)+1)) - l.jitter
+	}
+	if d <= 0 {
+		return
+	}
+	deadline := time.Now().Add(d)
+	if slack := sleepSlack(); d > slack {
+		time.Sleep(d - slack)
+	}
+	for time.Now().Before(deadline) {
+		runtime.Gosched()
+	}
+}
+
+// parseLatency 从 DSN 中取出 latency 和 jitter 参数（time.ParseDuration 格式），返回去掉它们的 DSN
+func parseLatency(dsn string) (string, latency, error) {
+	var lat latency
+	base, query, ok := strings.Cut(dsn, "?")
+	if !ok {
+		return dsn, lat, nil
+	}
+	var kept []string
+	for _, param := range strings.Split(query, "&") {
+		key, value, _ := strings.Cut(param, "=")
+		var target *time.Duration
+		switch key {
+		case latencyParam:
+			target = &lat.base
+		case jitterParam:
+			target = &lat.jitter
+		default:
+			kept = append(kept, param)
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil || d < 0 {
+			return "", lat, fmt.Errorf("sqldriver: invalid %s %q in DSN", key, value)
+		}
+		*target = d
+	}
+	if len(kept) == 0 {
+		return base, lat, nil
+	}
+	return base + "?" + strings.Join(kept, "&"), lat, nil
+}
+
+// withLatency 在 DSN 上追加延迟参数，空值的参数不追加
+func withLatency(dsn, base, jitter string) string {
+	for _, p := range [][2]string{{latencyParam, base}, {jitterParam, jitter}} {
+		if p[1] == "" {
+			continue
+		}
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		dsn += sep + p[0] + "=" + p[1]
+	}
+	return dsn
+}
@@ -0,0 +1,57 @@
+package sqldriver
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestParseLatency(t *testing.T) {
+	dsn, lat, err := parseLatency("file:x.db?cache=shared&latency=200us&mode=memory&jitter=50us")
+	if err != nil {
+		t.Fatalf("parseLatency failed: %v", err)
+	}
+	if dsn != "file:x.db?cache=shared&mode=memory" {
+		t.Errorf("dsn = %q", dsn)
+	}
+	if lat.base != 200*time.Microsecond || lat.jitter != 50*time.Microsecond {
+		t.Errorf("latency = %+v", lat)
+	}
+
+	if dsn, _, _ := parseLatency("file:x.db?latency=1ms"); dsn != "file:x.db" {
+		t.Errorf("dsn = %q, want file:x.db", dsn)
+	}
+	if _, _, err := parseLatency("file:x.db?latency=fast"); err == nil {
+		t.Error("expected error for invalid latency")
+	}
+}
+
+func TestLatencyDriver(t *testing.T) {
+	const delay = 2 * time.Millisecond
+	db, err := sql.Open(LatencyDriverName, withLatency("file:latency_test?mode=memory", delay.String(), ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec("CREATE TABLE t (id INTEGER)"); err != nil {
+		t.Fatal(err)
+	}
+	start := Snapshot()
+	begin := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := db.Exec("INSERT INTO t (id) VALUES (?)", i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	elapsed := time.Since(begin)
+	stats := Snapshot().Sub(start)
+
+	if stats.RoundTrips() != 5 {
+		t.Errorf("counted %d round trips, want 5", stats.RoundTrips())
+	}
+	if elapsed < 5*delay {
+		t.Errorf("5 round trips took %v, want at least %v", elapsed, 5*delay)
+	}
+}
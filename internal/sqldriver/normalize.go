@@ -0,0 +1,28 @@
+package sqldriver
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// 字符串和 blob 字面量，BUN 等在客户端格式化参数的实现会把参数内联进 SQL
+	stringLiteral = regexp.MustCompile(`(?:[xX])?'(?:[^']|'')*'`)
+	// 独立的数字字面量，标识符中的数字（如 wide8）不受影响
+	numberLiteral = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	// IN 列表中的占位符
+	placeholderList = regexp.MustCompile(`(?i)\bIN\s*\(\?(?:\s*,\s*\?)+\)`)
+	// 批量插入中重复的值元组
+	repeatedTuples = regexp.MustCompile(`(\([?, ]+\))(?:\s*,\s*\([?, ]+\))+`)
+)
+
+// Normalize 归一化 SQL，便于比较不同实现发出的语句：
+// 合并空白，字面量替换为 ?，IN 列表和批量插入的值元组只保留一项并以 ... 表示其余。
+func Normalize(query string) string {
+	query = strings.Join(strings.Fields(query), " ")
+	query = stringLiteral.ReplaceAllString(query, "?")
+	query = numberLiteral.ReplaceAllString(query, "?")
+	query = placeholderList.ReplaceAllString(query, "IN (?, ...)")
+	query = repeatedTuples.ReplaceAllString(query, "$1, ...")
+	return query
+}
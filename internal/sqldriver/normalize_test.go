@@ -0,0 +1,36 @@
+package sqldriver
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{
+			query: "SELECT *\n\t\tFROM users  WHERE id = ?",
+			want:  "SELECT * FROM users WHERE id = ?",
+		},
+		{
+			query: `SELECT "u"."id" FROM "users" AS "u" WHERE (id = 42) LIMIT 100 OFFSET 0`,
+			want:  `SELECT "u"."id" FROM "users" AS "u" WHERE (id = ?) LIMIT ? OFFSET ?`,
+		},
+		{
+			query: `INSERT INTO "users" ("name", "age") VALUES ('it''s', 20), ('user1', 21.5) RETURNING "id"`,
+			want:  `INSERT INTO "users" ("name", "age") VALUES (?, ?), ... RETURNING "id"`,
+		},
+		{
+			query: "SELECT * FROM users WHERE id IN (?, ?, ?) AND age in (?,?)",
+			want:  "SELECT * FROM users WHERE id IN (?, ...) AND age IN (?, ...)",
+		},
+		{
+			query: `INSERT INTO wide8 (col1, data) VALUES (?, X'00ff')`,
+			want:  `INSERT INTO wide8 (col1, data) VALUES (?, ?)`,
+		},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.query); got != tt.want {
+			t.Errorf("Normalize(%q)\n got: %s\nwant: %s", tt.query, got, tt.want)
+		}
+	}
+}
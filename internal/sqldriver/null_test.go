@@ -0,0 +1,96 @@
+package sqldriver
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestNullDriver(t *testing.T) {
+	db, err := sql.Open(NullDriverName, "null_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, age INTEGER, created_at DATETIME)"); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := db.Exec("INSERT INTO users (name, age, created_at) VALUES (?, ?, ?), (?, ?, ?)", "a", 1, time.Now(), "b", 2, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := res.LastInsertId(); id != 2 {
+		t.Errorf("LastInsertId = %d, want 2", id)
+	}
+	if n, _ := res.RowsAffected(); n != 2 {
+		t.Errorf("RowsAffected = %d, want 2", n)
+	}
+
+	var id int64
+	if err := db.QueryRow("INSERT INTO users (name, age, created_at) VALUES (?, ?, ?) RETURNING id", "c", 3, time.Now()).Scan(&id); err != nil {
+		t.Fatal(err)
+	}
+	if id != 3 {
+		t.Errorf("RETURNING id = %d, want 3", id)
+	}
+
+	var (
+		name      string
+		age       int
+		createdAt time.Time
+	)
+	if err := db.QueryRow("SELECT id, name, age, created_at FROM users WHERE id = ?", 1).Scan(&id, &name, &age, &createdAt); err != nil {
+		t.Fatal(err)
+	}
+	if name != "name" || age != 1 || createdAt.IsZero() {
+		t.Errorf("row = (%q, %d, %v), want canned values", name, age, createdAt)
+	}
+
+	for _, tc := range []struct {
+		query string
+		args  []any
+		want  int
+	}{
+		{"SELECT * FROM users LIMIT 10", nil, 10},
+		{"SELECT id FROM users WHERE id IN (?, ?, ?)", []any{1, 2, 3}, 3},
+		{"SELECT id FROM users WHERE id IN (4, 5) AND name <> 'it''s'", nil, 2},
+		{"SELECT id FROM users WHERE id = 1 LIMIT 10", nil, 1},
+		{"SELECT id FROM users WHERE age > 0 LIMIT ?", []any{5}, 5},
+		{"SELECT id FROM users WHERE name = 'x'", nil, 1},
+		{"SELECT id FROM missing", nil, 0},
+	} {
+		rows, err := db.Query(tc.query, tc.args...)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.query, err)
+		}
+		n := 0
+		for rows.Next() {
+			n++
+		}
+		rows.Close()
+		if n != tc.want {
+			t.Errorf("%s: %d rows, want %d", tc.query, n, tc.want)
+		}
+	}
+
+	// 声明类型之外指定的预制值优先；文本主键不按整数递增
+	const uuid = "00000000-0000-4000-8000-000000000001"
+	SetCannedValue("uuid_users", "id", uuid)
+	if _, err := db.Exec("CREATE TABLE uuid_users (id VARCHAR(36) PRIMARY KEY, name VARCHAR(100) NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+	var uuidID string
+	if err := db.QueryRow("SELECT id, name FROM uuid_users WHERE id = ?", uuid).Scan(&uuidID, &name); err != nil {
+		t.Fatal(err)
+	}
+	if uuidID != uuid {
+		t.Errorf("uuid_users id = %q, want %q", uuidID, uuid)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT count(*) FROM users").Scan(&count); err != nil || count != 0 {
+		t.Errorf("count = %d, %v; want 0", count, err)
+	}
+}
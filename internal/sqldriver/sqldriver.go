@@ -0,0 +1,331 @@
+// Package sqldriver 包装 go-sqlite3 驱动，统计各实现实际发给数据库的语句和往返次数。
+//
+// 所有实现都通过 Name 返回的驱动名和 DSN 处理后的连接串打开数据库。默认直接使用 go-sqlite3
+// 注册的 sqlite3 驱动，不经过包装，计时结果不含计数的开销；GOORM_DRIVER=sqlite3+trace 时
+// 改用带统计的包装驱动。包装层只在调用前后计数，不改变底层驱动实现的可选接口，
+// database/sql 的调用路径与直接使用 sqlite3 时一致；Rows 和 Result 原样返回。
+// 开启记录后还会保存每次调用的归一化 SQL，用于生成语句记录。
+//
+// LatencyDriverName 在计数之外为每次往返注入延迟，模拟远程数据库；
+// NullDriverName 不执行 SQL，立即返回预制结果，用于只测量 ORM 自身的开销。
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	// RawDriverName go-sqlite3 自身注册的驱动名，默认使用，不统计调用
+	RawDriverName = "sqlite3"
+	// DriverName 带统计的 sqlite3 驱动名，通过 GOORM_DRIVER 启用
+	DriverName = "sqlite3+trace"
+	// LatencyDriverName 带统计并为每次往返注入延迟的 sqlite3 驱动名，延迟由 DSN 参数指定
+	LatencyDriverName = "sqlite3+latency"
+)
+
+// 选择驱动和延迟的环境变量，运行器据此切换驱动，测试通过 Name 和 DSN 读取
+const (
+	DriverEnv  = "GOORM_DRIVER"
+	LatencyEnv = "GOORM_LATENCY"
+	JitterEnv  = "GOORM_JITTER"
+)
+
+func init() {
+	sql.Register(DriverName, &Driver{base: &sqlite3.SQLiteDriver{}})
+	sql.Register(LatencyDriverName, &Driver{base: &sqlite3.SQLiteDriver{}, delay: true})
+	sql.Register(NullDriverName, &Driver{base: &nullDriver{}})
+}
+
+// Names 返回本包注册的所有驱动名
+func Names() []string {
+	return []string{DriverName, LatencyDriverName, NullDriverName}
+}
+
+// Name 返回各实现打开数据库使用的驱动名，由 GOORM_DRIVER 指定，默认 RawDriverName
+func Name() string {
+	if name := os.Getenv(DriverEnv); name != "" {
+		return name
+	}
+	return RawDriverName
+}
+
+// Counting 当前驱动是否统计调用；本包注册的驱动都统计，go-sqlite3 本身不统计
+func Counting() bool {
+	return Name() != RawDriverName
+}
+
+// DSN 按当前驱动设置处理连接串：使用延迟驱动时追加 GOORM_LATENCY、GOORM_JITTER 指定的延迟参数
+func DSN(dsn string) string {
+	if Name() != LatencyDriverName {
+		return dsn
+	}
+	return withLatency(dsn, os.Getenv(LatencyEnv), os.Getenv(JitterEnv))
+}
+
+// Kind 驱动调用的类型，每次调用视为与数据库的一次往返
+type Kind int
+
+const (
+	KindPrepare Kind = iota
+	KindExec
+	KindQuery
+	KindBegin
+	KindCommit
+	KindRollback
+	numKinds
+)
+
+var kindNames = [numKinds]string{"prepare", "exec", "query", "begin", "commit", "rollback"}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// Stats 各类驱动调用的累计次数
+type Stats [numKinds]int64
+
+// Queries 执行的语句数（Exec + Query，含预编译语句的执行）
+func (s Stats) Queries() int64 {
+	return s[KindExec] + s[KindQuery]
+}
+
+// RoundTrips 与数据库的往返次数，即所有驱动调用之和
+func (s Stats) RoundTrips() int64 {
+	var n int64
+	for _, v := range s {
+		n += v
+	}
+	return n
+}
+
+// Add 返回 s 与 other 之和
+func (s Stats) Add(other Stats) Stats {
+	for i := range s {
+		s[i] += other[i]
+	}
+	return s
+}
+
+// Sub 返回 s 相对 base 的增量
+func (s Stats) Sub(base Stats) Stats {
+	for i := range s {
+		s[i] -= base[i]
+	}
+	return s
+}
+
+var counters [numKinds]atomic.Int64
+
+// Snapshot 返回当前的累计次数，两次快照之差即期间的调用次数
+func Snapshot() Stats {
+	var s Stats
+	for i := range counters {
+		s[i] = counters[i].Load()
+	}
+	return s
+}
+
+// Call 一次驱动调用的记录
+type Call struct {
+	Kind Kind
+	// Query 归一化后的 SQL，begin/commit/rollback 为空
+	Query string
+}
+
+var (
+	recording atomic.Bool
+	recordMu  sync.Mutex
+	recorded  []Call
+)
+
+// StartRecording 清空并开始记录驱动调用
+func StartRecording() {
+	recordMu.Lock()
+	recorded = nil
+	recordMu.Unlock()
+	recording.Store(true)
+}
+
+// StopRecording 停止记录，返回开始以来的调用
+func StopRecording() []Call {
+	recording.Store(false)
+	recordMu.Lock()
+	defer recordMu.Unlock()
+	calls := recorded
+	recorded = nil
+	return calls
+}
+
+// track 统计一次驱动调用
+func track(kind Kind, query string) {
+	counters[kind].Add(1)
+	if recording.Load() {
+		call := Call{Kind: kind}
+		if query != "" {
+			call.Query = Normalize(query)
+		}
+		recordMu.Lock()
+		recorded = append(recorded, call)
+		recordMu.Unlock()
+	}
+}
+
+// Driver 包装 driver.Driver，返回带统计的连接
+type Driver struct {
+	base driver.Driver
+	// delay 为 true 时从 DSN 中取出延迟参数，每次往返前等待
+	delay bool
+}
+
+func (d *Driver) Open(name string) (driver.Conn, error) {
+	var lat latency
+	if d.delay {
+		var err error
+		if name, lat, err = parseLatency(name); err != nil {
+			return nil, err
+		}
+	}
+	c, err := d.base.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{base: c.(baseConn), latency: lat}, nil
+}
+
+// baseConn 被包装连接需要实现的接口，*sqlite3.SQLiteConn 全部实现
+type baseConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.Pinger
+}
+
+type conn struct {
+	base    baseConn
+	latency latency
+}
+
+// roundTrip 统计一次往返，并按配置的延迟等待
+func (c *conn) roundTrip(kind Kind, query string) {
+	track(kind, query)
+	c.latency.wait()
+}
+
+var _ baseConn = (*conn)(nil)
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	c.roundTrip(KindPrepare, query)
+	s, err := c.base.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{base: s.(baseStmt), conn: c, query: query}, nil
+}
+
+func (c *conn) Close() error {
+	return c.base.Close()
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.roundTrip(KindBegin, "")
+	t, err := c.base.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{base: t, conn: c}, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	res, err := c.base.ExecContext(ctx, query, args)
+	if err != driver.ErrSkip {
+		c.roundTrip(KindExec, query)
+	}
+	return res, err
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.base.QueryContext(ctx, query, args)
+	if err != driver.ErrSkip {
+		c.roundTrip(KindQuery, query)
+	}
+	return rows, err
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	return c.base.Ping(ctx)
+}
+
+// baseStmt 被包装语句需要实现的接口，*sqlite3.SQLiteStmt 全部实现
+type baseStmt interface {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.StmtQueryContext
+}
+
+type stmt struct {
+	base  baseStmt
+	conn  *conn
+	query string
+}
+
+var _ baseStmt = (*stmt)(nil)
+
+func (s *stmt) Close() error {
+	return s.base.Close()
+}
+
+func (s *stmt) NumInput() int {
+	return s.base.NumInput()
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.roundTrip(KindExec, s.query)
+	return s.base.Exec(args)
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.conn.roundTrip(KindQuery, s.query)
+	return s.base.Query(args)
+}
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	s.conn.roundTrip(KindExec, s.query)
+	return s.base.ExecContext(ctx, args)
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	s.conn.roundTrip(KindQuery, s.query)
+	return s.base.QueryContext(ctx, args)
+}
+
+type tx struct {
+	base driver.Tx
+	conn *conn
+}
+
+func (t *tx) Commit() error {
+	t.conn.roundTrip(KindCommit, "")
+	return t.base.Commit()
+}
+
+func (t *tx) Rollback() error {
+	t.conn.roundTrip(KindRollback, "")
+	return t.base.Rollback()
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+// seedUsers 通过 InsertBatch 将 users 表补足到 total 行，from 为已有行数
+func seedUsers(tb testing.TB, o orm.Interface, from, total int) {
+	const batchSize = 1000
+	for start := from; start < total; start += batchSize {
+		end := min(start+batchSize, total)
+		users := make([]*models.User, 0, end-start)
+		for i := start; i < end; i++ {
+			users = append(users, &models.User{
+				Name:      fmt.Sprintf("user%d", i),
+				Email:     fmt.Sprintf("user%d@example.com", i),
+				Age:       20 + (i % 50),
+				CreatedAt: benchTime,
+				UpdatedAt: benchTime,
+			})
+		}
+		if err := o.InsertBatch(users); err != nil {
+			tb.Fatalf("Seed failed: %v", err)
+		}
+	}
+}
+
+// heapSampler 在后台周期性读取堆上存活对象的字节数，记录峰值
+type heapSampler struct {
+	stop chan struct{}
+	wg   sync.WaitGroup
+	peak uint64
+}
+
+const heapObjectsMetric = "/memory/classes/heap/objects:bytes"
+
+func readHeapObjects() uint64 {
+	sample := []metrics.Sample{{Name: heapObjectsMetric}}
+	metrics.Read(sample)
+	return sample[0].Value.Uint64()
+}
+
+func startHeapSampler() *heapSampler {
+	s := &heapSampler{stop: make(chan struct{}), peak: readHeapObjects()}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				s.peak = max(s.peak, readHeapObjects())
+			}
+		}
+	}()
+	return s
+}
+
+// Stop 停止采样并返回峰值
+func (s *heapSampler) Stop() uint64 {
+	close(s.stop)
+	s.wg.Wait()
+	return max(s.peak, readHeapObjects())
+}
+
+// TestIterate 验证 Iterate 按行返回与 GetAll 相同的数据，且回调出错时立即停止
+func TestIterate(t *testing.T) {
+	const total = 2500 // 超过 ENT 的分页大小，覆盖多页
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			o, cleanup, err := setupORM(ormName)
+			if err != nil {
+				t.Fatalf("Setup failed: %v", err)
+			}
+			defer cleanup()
+			seedUsers(t, o, 0, total)
+
+			want, err := o.GetAll(total, 0)
+			if err != nil {
+				t.Fatalf("GetAll failed: %v", err)
+			}
+			var got []*models.User
+			if err := o.Iterate(func(u *models.User) error {
+				got = append(got, u)
+				return nil
+			}); err != nil {
+				t.Fatalf("Iterate failed: %v", err)
+			}
+			if len(got) != total {
+				t.Fatalf("Iterate returned %d rows, want %d", len(got), total)
+			}
+			for i := range want {
+				if !reflect.DeepEqual(got[i], want[i]) {
+					t.Fatalf("row %d mismatch:\n got  %+v\n want %+v", i, got[i], want[i])
+				}
+			}
+
+			errStop := errors.New("stop")
+			calls := 0
+			err = o.Iterate(func(*models.User) error {
+				calls++
+				if calls == 10 {
+					return errStop
+				}
+				return nil
+			})
+			if !errors.Is(err, errStop) || calls != 10 {
+				t.Errorf("Iterate after callback error: err = %v, calls = %d; want %v, 10", err, calls, errStop)
+			}
+		})
+	}
+}
+
+// scanSizes 全表扫描的行数梯度
+var scanSizes = []struct {
+	name string
+	rows int
+}{
+	{"100k", 100_000},
+	{"1M", 1_000_000},
+}
+
+// BenchmarkFullScan 全表扫描测试：Iterate 流式读取与 GetAll 一次性读取对比，
+// 报告 ns/row 与扫描期间堆峰值增量 peak-heap-B
+func BenchmarkFullScan_GORM(b *testing.B) {
+	benchmarkFullScan(b, "gorm")
+}
+
+func BenchmarkFullScan_XORM(b *testing.B) {
+	benchmarkFullScan(b, "xorm")
+}
+
+func BenchmarkFullScan_ZORM(b *testing.B) {
+	benchmarkFullScan(b, "zorm")
+}
+
+func BenchmarkFullScan_SQLX(b *testing.B) {
+	benchmarkFullScan(b, "sqlx")
+}
+
+func BenchmarkFullScan_BORM(b *testing.B) {
+	benchmarkFullScan(b, "borm")
+}
+
+func BenchmarkFullScan_BUN(b *testing.B) {
+	benchmarkFullScan(b, "bun")
+}
+
+func BenchmarkFullScan_ENT(b *testing.B) {
+	benchmarkFullScan(b, "ent")
+}
+
+func BenchmarkFullScan_STDLIB(b *testing.B) {
+	benchmarkFullScan(b, "stdlib")
+}
+
+func BenchmarkFullScan_DIRECT(b *testing.B) {
+	benchmarkFullScan(b, "direct")
+}
+
+func benchmarkFullScan(b *testing.B, ormName string) {
+	skipIfCanned(b)
+	o, cleanup, err := setupORM(ormName)
+	if err != nil {
+		b.Fatalf("Setup failed: %v", err)
+	}
+	defer cleanup()
+
+	seeded := 0
+	for _, size := range scanSizes {
+		seedUsers(b, o, seeded, size.rows)
+		seeded = size.rows
+
+		scans := []struct {
+			name string
+			scan func() (int, error)
+		}{
+			{"iterate", func() (int, error) {
+				n := 0
+				err := o.Iterate(func(*models.User) error {
+					n++
+					return nil
+				})
+				return n, err
+			}},
+			{"getall", func() (int, error) {
+				users, err := o.GetAll(size.rows, 0)
+				return len(users), err
+			}},
+		}
+		for _, s := range scans {
+			b.Run(size.name+"/"+s.name, func(b *testing.B) {
+				runtime.GC()
+				base := readHeapObjects()
+				sampler := startHeapSampler()
+				b.ReportAllocs()
+				b.ResetTimer()
+				defer trackBenchmark(b).report()
+				for i := 0; i < b.N; i++ {
+					n, err := s.scan()
+					if err != nil {
+						b.Fatalf("%s failed: %v", s.name, err)
+					}
+					if n != size.rows {
+						b.Fatalf("%s returned %d rows, want %d", s.name, n, size.rows)
+					}
+				}
+				b.StopTimer()
+				peak := sampler.Stop()
+				b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*size.rows), "ns/row")
+				b.ReportMetric(float64(peak-min(base, peak)), "peak-heap-B")
+			})
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+func init() {
+	// 部分实现把 JSON 列声明为 TEXT，null 驱动按类型返回的文本不是合法的 JSON
+	table := models.Profile{}.TableName()
+	sqldriver.SetCannedValue(table, "settings", "{}")
+	sqldriver.SetCannedValue(table, "attrs", "{}")
+}
+
+// setupJSON 初始化 ORM 并创建 JSON 列表
+func setupJSON(tb testing.TB, ormName string) (orm.JSONInterface, string, func()) {
+	_, j, dsn, teardown := setupCapabilityWithDSN(tb, ormName, orm.JSONInterface.CreateJSONTable, orm.JSONInterface.DropJSONTable)
+	return j, dsn, teardown
+}
+
+// newProfile 生成测试用的 Profile，size 为 Attrs 编码后的近似字节数
+func newProfile(i, size int) *models.Profile {
+	theme := "light"
+	if i%2 == 0 {
+		theme = "dark"
+	}
+	profile := &models.Profile{
+		Name: fmt.Sprintf("user%d", i),
+		Settings: models.ProfileSettings{
+			Theme:    theme,
+			Language: "en",
+			Notify:   i%3 == 0,
+			Tags:     []string{"a", "b"},
+		},
+		Attrs: map[string]interface{}{
+			"level":  float64(i % 10),
+			"vip":    i%5 == 0,
+			"nested": map[string]interface{}{"city": "Shanghai"},
+		},
+	}
+	for n := 0; n*64 < size; n++ {
+		profile.Attrs[fmt.Sprintf("k%04d", n)] = strings.Repeat("x", 54)
+	}
+	return profile
+}
+
+// TestJSONRoundTrip 验证 JSON 列往返、存储字节一致以及 json_extract 查询
+func TestJSONRoundTrip(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			j, dsn, cleanup := setupJSON(t, ormName)
+			defer cleanup()
+
+			var profiles []*models.Profile
+			for i := 0; i < 4; i++ {
+				profile := newProfile(i, 0)
+				if err := j.InsertProfile(profile); err != nil {
+					t.Fatalf("InsertProfile failed: %v", err)
+				}
+				profiles = append(profiles, profile)
+			}
+
+			for _, want := range profiles {
+				got, err := j.GetProfileByID(want.ID)
+				if err != nil {
+					t.Fatalf("GetProfileByID failed: %v", err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("round trip mismatch:\n got  %+v\n want %+v", got, want)
+				}
+			}
+
+			dark, err := j.GetProfilesByTheme("dark")
+			if err != nil {
+				t.Fatalf("GetProfilesByTheme failed: %v", err)
+			}
+			if len(dark) != 2 || dark[0].ID != profiles[0].ID || dark[1].ID != profiles[2].ID {
+				t.Errorf("GetProfilesByTheme(dark) returned %d profiles, want IDs %d and %d",
+					len(dark), profiles[0].ID, profiles[2].ID)
+			}
+
+			// 直接读取存储内容：所有实现都应写入与 encoding/json 相同的字节。
+			// 存储类型允许不同：ent 以 []byte 绑定参数，SQLite 存为 BLOB，其余实现存为 TEXT。
+			db, err := sql.Open("sqlite3", dsn)
+			if err != nil {
+				t.Fatalf("open %s: %v", dsn, err)
+			}
+			defer db.Close()
+			for _, want := range profiles {
+				var settingsType, settings, attrsType, attrs string
+				err := db.QueryRow("SELECT typeof(settings), CAST(settings AS TEXT), typeof(attrs), CAST(attrs AS TEXT) FROM profiles WHERE id = ?", want.ID).
+					Scan(&settingsType, &settings, &attrsType, &attrs)
+				if err != nil {
+					t.Fatalf("read stored JSON: %v", err)
+				}
+				wantSettings, _ := json.Marshal(want.Settings)
+				wantAttrs, _ := json.Marshal(want.Attrs)
+				if settings != string(wantSettings) {
+					t.Errorf("stored settings = %s, want %s", settings, wantSettings)
+				}
+				if attrs != string(wantAttrs) {
+					t.Errorf("stored attrs = %s, want %s", attrs, wantAttrs)
+				}
+				if want == profiles[0] {
+					t.Logf("storage class: settings %s, attrs %s", settingsType, attrsType)
+				}
+			}
+		})
+	}
+}
+
+// jsonSizes JSON 文档大小梯度
+var jsonSizes = []struct {
+	name string
+	size int
+}{
+	{"small", 0},
+	{"10KB", 10 << 10},
+}
+
+// BenchmarkJSONInsert JSON 列插入测试（小文档与 10KB 文档）
+func BenchmarkJSONInsert_GORM(b *testing.B) {
+	benchmarkJSONInsert(b, "gorm")
+}
+
+func BenchmarkJSONInsert_XORM(b *testing.B) {
+	benchmarkJSONInsert(b, "xorm")
+}
+
+func BenchmarkJSONInsert_ZORM(b *testing.B) {
+	benchmarkJSONInsert(b, "zorm")
+}
+
+func BenchmarkJSONInsert_SQLX(b *testing.B) {
+	benchmarkJSONInsert(b, "sqlx")
+}
+
+func BenchmarkJSONInsert_BORM(b *testing.B) {
+	benchmarkJSONInsert(b, "borm")
+}
+
+func BenchmarkJSONInsert_BUN(b *testing.B) {
+	benchmarkJSONInsert(b, "bun")
+}
+
+func BenchmarkJSONInsert_ENT(b *testing.B) {
+	benchmarkJSONInsert(b, "ent")
+}
+
+func BenchmarkJSONInsert_STDLIB(b *testing.B) {
+	benchmarkJSONInsert(b, "stdlib")
+}
+
+func benchmarkJSONInsert(b *testing.B, ormName string) {
+	j, _, cleanup := setupJSON(b, ormName)
+	defer cleanup()
+
+	for _, size := range jsonSizes {
+		b.Run(size.name, func(b *testing.B) {
+			profiles := make([]*models.Profile, b.N)
+			for i := range profiles {
+				profiles[i] = newProfile(i, size.size)
+			}
+			b.ResetTimer()
+			b.ReportAllocs()
+			defer trackBenchmark(b).report()
+			for i := 0; i < b.N; i++ {
+				if err := j.InsertProfile(profiles[i]); err != nil {
+					b.Fatalf("InsertProfile failed: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkJSONSelect JSON 列按 ID 查询测试（小文档与 10KB 文档）
+func BenchmarkJSONSelect_GORM(b *testing.B) {
+	benchmarkJSONSelect(b, "gorm")
+}
+
+func BenchmarkJSONSelect_XORM(b *testing.B) {
+	benchmarkJSONSelect(b, "xorm")
+}
+
+func BenchmarkJSONSelect_ZORM(b *testing.B) {
+	benchmarkJSONSelect(b, "zorm")
+}
+
+func BenchmarkJSONSelect_SQLX(b *testing.B) {
+	benchmarkJSONSelect(b, "sqlx")
+}
+
+func BenchmarkJSONSelect_BORM(b *testing.B) {
+	benchmarkJSONSelect(b, "borm")
+}
+
+func BenchmarkJSONSelect_BUN(b *testing.B) {
+	benchmarkJSONSelect(b, "bun")
+}
+
+func BenchmarkJSONSelect_ENT(b *testing.B) {
+	benchmarkJSONSelect(b, "ent")
+}
+
+func BenchmarkJSONSelect_STDLIB(b *testing.B) {
+	benchmarkJSONSelect(b, "stdlib")
+}
+
+func benchmarkJSONSelect(b *testing.B, ormName string) {
+	j, _, cleanup := setupJSON(b, ormName)
+	defer cleanup()
+
+	for _, size := range jsonSizes {
+		// 预先插入一些数据
+		var ids []int64
+		for i := 0; i < 1000; i++ {
+			profile := newProfile(i, size.size)
+			if err := j.InsertProfile(profile); err != nil {
+				b.Fatalf("Pre-insert failed: %v", err)
+			}
+			ids = append(ids, profile.ID)
+		}
+
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			defer trackBenchmark(b).report()
+			for i := 0; i < b.N; i++ {
+				if _, err := j.GetProfileByID(ids[i%len(ids)]); err != nil {
+					b.Fatalf("GetProfileByID failed: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+	"github.com/google/uuid"
+)
+
+// 主键策略测试的批量大小和查询前预先写入的行数
+const (
+	keyBatchSize  = 100
+	keyLookupRows = 1000
+)
+
+func init() {
+	// null 驱动按类型返回的文本主键不是合法的 UUID
+	sqldriver.SetCannedValue(models.UUIDUser{}.TableName(), "id", "00000000-0000-4000-8000-000000000001")
+}
+
+// snowflakeEpoch snowflake ID 时间戳的起点
+var snowflakeEpoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// snowflake 生成 snowflake 风格的 int64 ID：41 位毫秒时间戳、10 位节点号、12 位序号，按生成顺序递增。
+// 同一毫秒内序号用尽时借用下一毫秒
+type snowflake struct {
+	mu     sync.Mutex
+	node   int64
+	lastMs int64
+	seq    int64
+}
+
+func (s *snowflake) next() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ms := time.Since(snowflakeEpoch).Milliseconds()
+	if ms <= s.lastMs {
+		ms = s.lastMs
+		s.seq++
+		if s.seq == 1<<12 {
+			ms++
+			s.seq = 0
+		}
+	} else {
+		s.seq = 0
+	}
+	s.lastMs = ms
+	return ms<<22 | s.node<<12 | s.seq
+}
+
+// setupKeys 初始化 ORM 并创建 uuid_users、snowflake_users 表，users 表作为自增主键的对照
+func setupKeys(tb testing.TB, ormName string) (orm.Interface, orm.KeyInterface, string, func()) {
+	return setupCapabilityWithDSN(tb, ormName, orm.KeyInterface.CreateKeyTables, orm.KeyInterface.DropKeyTables)
+}
+
+func newUUIDUser(i int) *models.UUIDUser {
+	return &models.UUIDUser{
+		ID:        uuid.New(),
+		Name:      fmt.Sprintf("user%d", i),
+		Email:     fmt.Sprintf("user%d@example.com", i),
+		Age:       20 + i%50,
+		CreatedAt: benchTime,
+		UpdatedAt: benchTime,
+	}
+}
+
+func newSnowflakeUser(i int, ids *snowflake) *models.SnowflakeUser {
+	return &models.SnowflakeUser{
+		ID:        ids.next(),
+		Name:      fmt.Sprintf("user%d", i),
+		Email:     fmt.Sprintf("user%d@example.com", i),
+		Age:       20 + i%50,
+		CreatedAt: benchTime,
+		UpdatedAt: benchTime,
+	}
+}
+
+// TestKeys 验证客户端生成的主键原样写入并可按主键查询：UUID 以 36 个字符的文本存储，
+// snowflake ID 超出 2^53 也不丢失精度；重复的主键被拒绝
+func TestKeys(t *testing.T) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		t.Skip("null driver returns canned results")
+	}
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			_, k, dsn, cleanup := setupKeys(t, ormName)
+			defer cleanup()
+
+			user := newUUIDUser(0)
+			batch := []*models.UUIDUser{newUUIDUser(1), newUUIDUser(2), newUUIDUser(3)}
+			if err := k.InsertUUIDUser(user); err != nil {
+				t.Fatalf("InsertUUIDUser failed: %v", err)
+			}
+			if err := k.InsertUUIDBatch(batch); err != nil {
+				t.Fatalf("InsertUUIDBatch failed: %v", err)
+			}
+			for _, want := range append([]*models.UUIDUser{user}, batch...) {
+				got, err := k.GetUUIDUserByID(want.ID)
+				if err != nil {
+					t.Fatalf("GetUUIDUserByID(%s) failed: %v", want.ID, err)
+				}
+				if got.ID != want.ID || got.Name != want.Name || got.Email != want.Email || got.Age != want.Age || !got.CreatedAt.Equal(want.CreatedAt) {
+					t.Errorf("GetUUIDUserByID = %+v, want %+v", got, want)
+				}
+			}
+			if _, err := k.GetUUIDUserByID(uuid.New()); err == nil {
+				t.Errorf("GetUUIDUserByID of missing ID succeeded")
+			}
+			duplicate := newUUIDUser(4)
+			duplicate.ID = user.ID
+			if err := k.InsertUUIDUser(duplicate); err == nil {
+				t.Errorf("InsertUUIDUser with duplicate ID succeeded")
+			}
+
+			ids := &snowflake{node: 1}
+			flake := newSnowflakeUser(0, ids)
+			flakes := []*models.SnowflakeUser{newSnowflakeUser(1, ids), newSnowflakeUser(2, ids), newSnowflakeUser(3, ids)}
+			if flake.ID <= 1<<53 {
+				t.Fatalf("snowflake ID %d does not exceed 2^53", flake.ID)
+			}
+			if err := k.InsertSnowflakeUser(flake); err != nil {
+				t.Fatalf("InsertSnowflakeUser failed: %v", err)
+			}
+			if err := k.InsertSnowflakeBatch(flakes); err != nil {
+				t.Fatalf("InsertSnowflakeBatch failed: %v", err)
+			}
+			for _, want := range append([]*models.SnowflakeUser{flake}, flakes...) {
+				got, err := k.GetSnowflakeUserByID(want.ID)
+				if err != nil {
+					t.Fatalf("GetSnowflakeUserByID(%d) failed: %v", want.ID, err)
+				}
+				if got.ID != want.ID || got.Name != want.Name || got.Email != want.Email || got.Age != want.Age || !got.CreatedAt.Equal(want.CreatedAt) {
+					t.Errorf("GetSnowflakeUserByID = %+v, want %+v", got, want)
+				}
+			}
+			if _, err := k.GetSnowflakeUserByID(ids.next()); err == nil {
+				t.Errorf("GetSnowflakeUserByID of missing ID succeeded")
+			}
+			if err := k.InsertSnowflakeUser(newSnowflakeUser(4, ids)); err != nil {
+				t.Fatalf("InsertSnowflakeUser failed: %v", err)
+			}
+			dup := newSnowflakeUser(5, ids)
+			dup.ID = flake.ID
+			if err := k.InsertSnowflakeUser(dup); err == nil {
+				t.Errorf("InsertSnowflakeUser with duplicate ID succeeded")
+			}
+
+			db, err := sql.Open("sqlite3", dsn)
+			if err != nil {
+				t.Fatalf("open %s: %v", dsn, err)
+			}
+			defer db.Close()
+			var stored string
+			err = db.QueryRow("SELECT typeof(id) || ':' || length(id) FROM uuid_users WHERE id = ?", user.ID.String()).Scan(&stored)
+			if err != nil {
+				t.Fatalf("read uuid_users: %v", err)
+			}
+			if stored != "text:36" {
+				t.Errorf("UUID stored as %s, want text:36", stored)
+			}
+		})
+	}
+}
+
+// BenchmarkKeys 主键策略对比：users 表的自增主键（autoincr）、客户端生成的文本 UUID（uuid）
+// 和 snowflake 风格的 int64（snowflake），分别测试单条插入、按主键查询和每批 100 行的批量插入。
+// ID 在计时前生成
+func BenchmarkKeys_GORM(b *testing.B) {
+	benchmarkKeys(b, "gorm")
+}
+
+func BenchmarkKeys_XORM(b *testing.B) {
+	benchmarkKeys(b, "xorm")
+}
+
+func BenchmarkKeys_ZORM(b *testing.B) {
+	benchmarkKeys(b, "zorm")
+}
+
+func BenchmarkKeys_SQLX(b *testing.B) {
+	benchmarkKeys(b, "sqlx")
+}
+
+func BenchmarkKeys_BORM(b *testing.B) {
+	benchmarkKeys(b, "borm")
+}
+
+func BenchmarkKeys_BUN(b *testing.B) {
+	benchmarkKeys(b, "bun")
+}
+
+func BenchmarkKeys_ENT(b *testing.B) {
+	benchmarkKeys(b, "ent")
+}
+
+func BenchmarkKeys_STDLIB(b *testing.B) {
+	benchmarkKeys(b, "stdlib")
+}
+
+func BenchmarkKeys_DIRECT(b *testing.B) {
+	benchmarkKeys(b, "direct")
+}
+
+func benchmarkKeys(b *testing.B, ormName string) {
+	o, k, _, cleanup := setupKeys(b, ormName)
+	defer cleanup()
+
+	b.Run("autoincr", func(b *testing.B) {
+		benchmarkKeyStrategy(b, newUser, o.Insert, o.InsertBatch, func(u *models.User) error {
+			_, err := o.GetByID(u.ID)
+			return err
+		})
+	})
+	b.Run("uuid", func(b *testing.B) {
+		benchmarkKeyStrategy(b, newUUIDUser, k.InsertUUIDUser, k.InsertUUIDBatch, func(u *models.UUIDUser) error {
+			_, err := k.GetUUIDUserByID(u.ID)
+			return err
+		})
+	})
+	ids := &snowflake{node: 1}
+	b.Run("snowflake", func(b *testing.B) {
+		newUser := func(i int) *models.SnowflakeUser { return newSnowflakeUser(i, ids) }
+		benchmarkKeyStrategy(b, newUser, k.InsertSnowflakeUser, k.InsertSnowflakeBatch, func(u *models.SnowflakeUser) error {
+			_, err := k.GetSnowflakeUserByID(u.ID)
+			return err
+		})
+	})
+}
+
+// benchmarkKeyStrategy 对一种主键策略执行 insert、lookup、batch 子测试；newRow 生成带 ID 的行（自增主键除外）
+func benchmarkKeyStrategy[T any](b *testing.B, newRow func(i int) T, insert func(T) error, insertBatch func([]T) error, lookup func(T) error) {
+	newRows := func(n int) []T {
+		rows := make([]T, n)
+		for i := range rows {
+			rows[i] = newRow(i)
+		}
+		return rows
+	}
+
+	b.Run("insert", func(b *testing.B) {
+		rows := newRows(b.N)
+		b.ResetTimer()
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+
+		for i := 0; i < b.N; i++ {
+			if err := insert(rows[i]); err != nil {
+				b.Fatalf("Insert failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("lookup", func(b *testing.B) {
+		rows := newRows(keyLookupRows)
+		for i := 0; i < len(rows); i += keyBatchSize {
+			if err := insertBatch(rows[i : i+keyBatchSize]); err != nil {
+				b.Fatalf("Pre-insert failed: %v", err)
+			}
+		}
+		b.ResetTimer()
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+
+		for i := 0; i < b.N; i++ {
+			if err := lookup(rows[i%len(rows)]); err != nil {
+				b.Fatalf("Lookup failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("batch", func(b *testing.B) {
+		rows := newRows(b.N * keyBatchSize)
+		b.ResetTimer()
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+
+		for i := 0; i < b.N; i++ {
+			if err := insertBatch(rows[i*keyBatchSize : (i+1)*keyBatchSize]); err != nil {
+				b.Fatalf("InsertBatch failed: %v", err)
+			}
+		}
+		b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*keyBatchSize), "ns/row")
+	})
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+// setupNullable 初始化 ORM 并创建可空列表
+func setupNullable(tb testing.TB, ormName string) (orm.NullableInterface, func()) {
+	return setupCapability(tb, ormName, orm.NullableInterface.CreateNullableTable, orm.NullableInterface.DropNullableTable)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// nullableCases NULL、零值和非零值三种状态
+var nullableCases = []struct {
+	name string
+	user models.NullableUser
+}{
+	{"null", models.NullableUser{}},
+	{"zero", models.NullableUser{
+		Name:  stringPtr(""),
+		Email: sql.NullString{Valid: true},
+		Age:   sql.Null[int64]{Valid: true},
+	}},
+	{"value", models.NullableUser{
+		Name:  stringPtr("alice"),
+		Email: sql.NullString{String: "alice@example.com", Valid: true},
+		Age:   sql.Null[int64]{V: 30, Valid: true},
+	}},
+}
+
+// TestNullableRoundTrip 验证 NULL、零值和非零值经过插入、更新、查询后保持不变
+func TestNullableRoundTrip(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			nullable, cleanup := setupNullable(t, ormName)
+			defer cleanup()
+
+			for _, from := range nullableCases {
+				for _, to := range nullableCases {
+					t.Run(from.name+"->"+to.name, func(t *testing.T) {
+						user := from.user
+						if err := nullable.InsertNullable(&user); err != nil {
+							t.Fatalf("InsertNullable failed: %v", err)
+						}
+						if user.ID == 0 {
+							t.Fatalf("InsertNullable did not set ID")
+						}
+						assertNullableStored(t, nullable, &user)
+
+						updated := to.user
+						updated.ID = user.ID
+						if err := nullable.UpdateNullable(&updated); err != nil {
+							t.Fatalf("UpdateNullable failed: %v", err)
+						}
+						assertNullableStored(t, nullable, &updated)
+					})
+				}
+			}
+		})
+	}
+}
+
+// TestNullablePlainRead 验证 GetPlainAll 按 ID 顺序读出与 GetNullableAll 相同的非 NULL 行
+func TestNullablePlainRead(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			nullable, cleanup := setupNullable(t, ormName)
+			defer cleanup()
+
+			var want []*models.PlainUser
+			for i := 0; i < 5; i++ {
+				user := &models.NullableUser{
+					Name:  stringPtr(fmt.Sprintf("user%d", i)),
+					Email: sql.NullString{String: fmt.Sprintf("user%d@example.com", i), Valid: true},
+					Age:   sql.Null[int64]{V: int64(20 + i), Valid: true},
+				}
+				if err := nullable.InsertNullable(user); err != nil {
+					t.Fatalf("InsertNullable failed: %v", err)
+				}
+				want = append(want, &models.PlainUser{ID: user.ID, Name: *user.Name, Email: user.Email.String, Age: user.Age.V})
+			}
+
+			got, err := nullable.GetPlainAll(3, 1)
+			if err != nil {
+				t.Fatalf("GetPlainAll failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, want[1:4]) {
+				t.Errorf("GetPlainAll(3, 1) returned %v, want %v", got, want[1:4])
+			}
+		})
+	}
+}
+
+func assertNullableStored(t *testing.T, nullable orm.NullableInterface, want *models.NullableUser) {
+	t.Helper()
+	got, err := nullable.GetNullableByID(want.ID)
+	if err != nil {
+		t.Fatalf("GetNullableByID failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip mismatch:\n got  %s\n want %s", formatNullable(got), formatNullable(want))
+	}
+}
+
+func formatNullable(u *models.NullableUser) string {
+	name := "NULL"
+	if u.Name != nil {
+		name = fmt.Sprintf("%q", *u.Name)
+	}
+	email := "NULL"
+	if u.Email.Valid {
+		email = fmt.Sprintf("%q", u.Email.String)
+	}
+	age := "NULL"
+	if u.Age.Valid {
+		age = fmt.Sprint(u.Age.V)
+	}
+	return fmt.Sprintf("{ID:%d Name:%s Email:%s Age:%s}", u.ID, name, email, age)
+}
+
+// BenchmarkNullableSelect 可空列扫描开销测试
+func BenchmarkNullableSelect_GORM(b *testing.B) {
+	benchmarkNullableSelect(b, "gorm")
+}
+
+func BenchmarkNullableSelect_XORM(b *testing.B) {
+	benchmarkNullableSelect(b, "xorm")
+}
+
+func BenchmarkNullableSelect_ZORM(b *testing.B) {
+	benchmarkNullableSelect(b, "zorm")
+}
+
+func BenchmarkNullableSelect_SQLX(b *testing.B) {
+	benchmarkNullableSelect(b, "sqlx")
+}
+
+func BenchmarkNullableSelect_BORM(b *testing.B) {
+	benchmarkNullableSelect(b, "borm")
+}
+
+func BenchmarkNullableSelect_BUN(b *testing.B) {
+	benchmarkNullableSelect(b, "bun")
+}
+
+func BenchmarkNullableSelect_ENT(b *testing.B) {
+	benchmarkNullableSelect(b, "ent")
+}
+
+func BenchmarkNullableSelect_STDLIB(b *testing.B) {
+	benchmarkNullableSelect(b, "stdlib")
+}
+
+func benchmarkNullableSelect(b *testing.B, ormName string) {
+	nullable, cleanup := setupNullable(b, ormName)
+	defer cleanup()
+
+	// 预先插入一些数据：前 1000 行全 NULL，后 1000 行有值
+	for i := 0; i < 1000; i++ {
+		if err := nullable.InsertNullable(&models.NullableUser{}); err != nil {
+			b.Fatalf("Pre-insert failed: %v", err)
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		user := &models.NullableUser{
+			Name:  stringPtr(fmt.Sprintf("user%d", i)),
+			Email: sql.NullString{String: fmt.Sprintf("user%d@example.com", i), Valid: true},
+			Age:   sql.Null[int64]{V: int64(20 + (i % 50)), Valid: true},
+		}
+		if err := nullable.InsertNullable(user); err != nil {
+			b.Fatalf("Pre-insert failed: %v", err)
+		}
+	}
+
+	limit := 100
+	// baseline 读取与 values 相同的行和列，映射到非空类型
+	b.Run("baseline", func(b *testing.B) {
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+		for i := 0; i < b.N; i++ {
+			offset := 1000 + (i*limit)%900
+			if _, err := nullable.GetPlainAll(limit, offset); err != nil {
+				b.Fatalf("GetPlainAll failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("nulls", func(b *testing.B) {
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+		for i := 0; i < b.N; i++ {
+			offset := (i * limit) % 900
+			if _, err := nullable.GetNullableAll(limit, offset); err != nil {
+				b.Fatalf("GetNullableAll failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("values", func(b *testing.B) {
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+		for i := 0; i < b.N; i++ {
+			offset := 1000 + (i*limit)%900
+			if _, err := nullable.GetNullableAll(limit, offset); err != nil {
+				b.Fatalf("GetNullableAll failed: %v", err)
+			}
+		}
+	})
+}
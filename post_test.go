@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+func init() {
+	// XORM 把 TEXT 列上的 []byte 按 JSON 字符串编解码，null 驱动返回空的 JSON 字符串使各实现都能读取
+	sqldriver.SetCannedValue(models.Post{}.TableName(), "body", `""`)
+}
+
+// setupPost 初始化 ORM 并创建 posts 表
+func setupPost(tb testing.TB, ormName string) (orm.PostInterface, func()) {
+	return setupCapability(tb, ormName, orm.PostInterface.CreatePostTable, orm.PostInterface.DropPostTable)
+}
+
+// postSizes 文章正文大小梯度
+var postSizes = []struct {
+	name string
+	size int
+}{
+	{"1KB", 1 << 10},
+	{"64KB", 64 << 10},
+	{"1MB", 1 << 20},
+}
+
+// newPost 生成正文为 size 字节可打印字符的测试文章
+func newPost(i, size int) *models.Post {
+	body := make([]byte, size)
+	for n := range body {
+		body[n] = 'a' + byte((n+i)%26)
+	}
+	return &models.Post{
+		UserID: int64(i),
+		Title:  fmt.Sprintf("post%d", i),
+		Body:   body,
+	}
+}
+
+// totalAlloc 返回进程累计分配的字节数
+func totalAlloc() uint64 {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return ms.TotalAlloc
+}
+
+// reportCopyRatio 报告每字节正文对应的分配字节数（B/payload-B），
+// 约等于 ORM 与驱动在读写路径上复制正文的次数
+func reportCopyRatio(b *testing.B, allocated uint64, size int) {
+	b.ReportMetric(float64(allocated)/float64(b.N)/float64(size), "B/payload-B")
+}
+
+// TestPostRoundTrip 验证各大小的正文能完整往返
+func TestPostRoundTrip(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			p, cleanup := setupPost(t, ormName)
+			defer cleanup()
+
+			for i, size := range postSizes {
+				want := newPost(i, size.size)
+				if err := p.InsertPost(want); err != nil {
+					t.Fatalf("InsertPost(%s) failed: %v", size.name, err)
+				}
+				got, err := p.GetPostByID(want.ID)
+				if err != nil {
+					t.Fatalf("GetPostByID(%s) failed: %v", size.name, err)
+				}
+				if got.UserID != want.UserID || got.Title != want.Title || !bytes.Equal(got.Body, want.Body) {
+					t.Errorf("%s round trip mismatch: got %d-byte body, want %d", size.name, len(got.Body), len(want.Body))
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkPostInsert 大字段插入测试（1KB、64KB、1MB 正文）
+func BenchmarkPostInsert_GORM(b *testing.B) {
+	benchmarkPostInsert(b, "gorm")
+}
+
+func BenchmarkPostInsert_XORM(b *testing.B) {
+	benchmarkPostInsert(b, "xorm")
+}
+
+func BenchmarkPostInsert_ZORM(b *testing.B) {
+	benchmarkPostInsert(b, "zorm")
+}
+
+func BenchmarkPostInsert_SQLX(b *testing.B) {
+	benchmarkPostInsert(b, "sqlx")
+}
+
+func BenchmarkPostInsert_BORM(b *testing.B) {
+	benchmarkPostInsert(b, "borm")
+}
+
+func BenchmarkPostInsert_BUN(b *testing.B) {
+	benchmarkPostInsert(b, "bun")
+}
+
+func BenchmarkPostInsert_ENT(b *testing.B) {
+	benchmarkPostInsert(b, "ent")
+}
+
+func BenchmarkPostInsert_STDLIB(b *testing.B) {
+	benchmarkPostInsert(b, "stdlib")
+}
+
+// postInsertBatch 插入测试每插入多少行清理一次，避免内存库随 b.N 无限增长
+const postInsertBatch = 64
+
+func benchmarkPostInsert(b *testing.B, ormName string) {
+	p, cleanup := setupPost(b, ormName)
+	defer cleanup()
+
+	for _, size := range postSizes {
+		b.Run(size.name, func(b *testing.B) {
+			post := newPost(0, size.size)
+			ids := make([]int64, 0, postInsertBatch)
+			var cleanupAlloc uint64
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			tracker := trackBenchmark(b)
+			defer tracker.report()
+			start := totalAlloc()
+			for i := 0; i < b.N; i++ {
+				post.ID = 0
+				if err := p.InsertPost(post); err != nil {
+					b.Fatalf("InsertPost failed: %v", err)
+				}
+				ids = append(ids, post.ID)
+
+				if len(ids) == postInsertBatch {
+					b.StopTimer()
+					tracker.pause()
+					before := totalAlloc()
+					for _, id := range ids {
+						if err := p.DeletePost(id); err != nil {
+							b.Fatalf("DeletePost failed: %v", err)
+						}
+					}
+					ids = ids[:0]
+					cleanupAlloc += totalAlloc() - before
+					tracker.resume()
+					b.StartTimer()
+				}
+			}
+			b.StopTimer()
+			reportCopyRatio(b, totalAlloc()-start-cleanupAlloc, size.size)
+		})
+	}
+}
+
+// BenchmarkPostFetch 大字段按 ID 查询测试（1KB、64KB、1MB 正文）
+func BenchmarkPostFetch_GORM(b *testing.B) {
+	benchmarkPostFetch(b, "gorm")
+}
+
+func BenchmarkPostFetch_XORM(b *testing.B) {
+	benchmarkPostFetch(b, "xorm")
+}
+
+func BenchmarkPostFetch_ZORM(b *testing.B) {
+	benchmarkPostFetch(b, "zorm")
+}
+
+func BenchmarkPostFetch_SQLX(b *testing.B) {
+	benchmarkPostFetch(b, "sqlx")
+}
+
+func BenchmarkPostFetch_BORM(b *testing.B) {
+	benchmarkPostFetch(b, "borm")
+}
+
+func BenchmarkPostFetch_BUN(b *testing.B) {
+	benchmarkPostFetch(b, "bun")
+}
+
+func BenchmarkPostFetch_ENT(b *testing.B) {
+	benchmarkPostFetch(b, "ent")
+}
+
+func BenchmarkPostFetch_STDLIB(b *testing.B) {
+	benchmarkPostFetch(b, "stdlib")
+}
+
+func benchmarkPostFetch(b *testing.B, ormName string) {
+	p, cleanup := setupPost(b, ormName)
+	defer cleanup()
+
+	for _, size := range postSizes {
+		// 预先插入一些数据
+		var ids []int64
+		for i := 0; i < 10; i++ {
+			post := newPost(i, size.size)
+			if err := p.InsertPost(post); err != nil {
+				b.Fatalf("Pre-insert failed: %v", err)
+			}
+			ids = append(ids, post.ID)
+		}
+
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			defer trackBenchmark(b).report()
+			start := totalAlloc()
+			for i := 0; i < b.N; i++ {
+				if _, err := p.GetPostByID(ids[i%len(ids)]); err != nil {
+					b.Fatalf("GetPostByID failed: %v", err)
+				}
+			}
+			b.StopTimer()
+			reportCopyRatio(b, totalAlloc()-start, size.size)
+		})
+	}
+}
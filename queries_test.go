@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// sqlDirEnv 设置时，每个基准测试把计时区间内的驱动调用写成 SQL 记录文件到该目录
+const sqlDirEnv = "GOORM_SQL_DIR"
+
+// queryTracker 统计基准测试计时区间内的驱动调用，报告 queries/op 和 roundtrips/op。
+// 只有 GOORM_DRIVER 选择了统计调用的驱动时才报告，默认的 sqlite3 驱动不计数
+type queryTracker struct {
+	b        *testing.B
+	start    sqldriver.Stats
+	paused   sqldriver.Stats // 暂停期间的调用，报告时扣除
+	pausedAt sqldriver.Stats
+	dir      string
+	calls    []sqldriver.Call
+}
+
+// trackQueries 开始统计驱动调用，基准测试通过 trackBenchmark 使用
+func trackQueries(b *testing.B) *queryTracker {
+	q := &queryTracker{b: b, dir: os.Getenv(sqlDirEnv)}
+	if q.dir != "" {
+		sqldriver.StartRecording()
+	}
+	q.start = sqldriver.Snapshot()
+	return q
+}
+
+// pause 暂停统计，与 b.StopTimer 配合排除计时区间外的调用
+func (q *queryTracker) pause() {
+	q.pausedAt = sqldriver.Snapshot()
+	if q.dir != "" {
+		q.calls = append(q.calls, sqldriver.StopRecording()...)
+	}
+}
+
+// resume 恢复统计
+func (q *queryTracker) resume() {
+	q.paused = q.paused.Add(sqldriver.Snapshot().Sub(q.pausedAt))
+	if q.dir != "" {
+		sqldriver.StartRecording()
+	}
+}
+
+// report 报告每次操作的语句数和往返次数，并按需写出 SQL 记录
+func (q *queryTracker) report() {
+	if !sqldriver.Counting() {
+		return
+	}
+	stats := sqldriver.Snapshot().Sub(q.start).Sub(q.paused)
+	n := float64(q.b.N)
+	q.b.ReportMetric(float64(stats.Queries())/n, "queries/op")
+	q.b.ReportMetric(float64(stats.RoundTrips())/n, "roundtrips/op")
+
+	if q.dir == "" {
+		return
+	}
+	q.calls = append(q.calls, sqldriver.StopRecording()...)
+	if err := writeTranscript(q.dir, q.b.Name(), q.b.N, stats, q.calls); err != nil {
+		q.b.Errorf("write SQL transcript: %v", err)
+	}
+}
+
+// writeTranscript 写出一个基准测试的 SQL 记录，文件名为去掉 Benchmark 前缀的测试名，
+// 连续重复的调用合并为一行并标注次数
+func writeTranscript(dir, name string, n int, stats sqldriver.Stats, calls []sqldriver.Call) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "-- %s: %d op(s), %d queries, %d round trips\n", name, n, stats.Queries(), stats.RoundTrips())
+	for i := 0; i < len(calls); {
+		j := i + 1
+		for j < len(calls) && calls[j] == calls[i] {
+			j++
+		}
+		line := calls[i].Kind.String()
+		if calls[i].Query != "" {
+			line = fmt.Sprintf("%-8s %s", line, calls[i].Query)
+		}
+		if j-i > 1 {
+			line += fmt.Sprintf(" -- x%d", j-i)
+		}
+		b.WriteString(line + "\n")
+		i = j
+	}
+
+	file := strings.ReplaceAll(strings.TrimPrefix(name, "Benchmark"), "/", "_") + ".sql"
+	return os.WriteFile(filepath.Join(dir, file), []byte(b.String()), 0o644)
+}
+
+// TestQueryCounting 确认启用统计后所有实现都经过带统计的驱动，stdlib 每个操作恰好一次往返
+func TestQueryCounting(t *testing.T) {
+	if !sqldriver.Counting() {
+		t.Setenv(sqldriver.DriverEnv, sqldriver.DriverName)
+	}
+	for _, name := range ormNames() {
+		t.Run(name, func(t *testing.T) {
+			o, cleanup, err := setupORM(name)
+			if err != nil {
+				t.Fatalf("Setup failed: %v", err)
+			}
+			defer cleanup()
+
+			user := &models.User{Name: "user", Email: "user@example.com", Age: 20, CreatedAt: benchTime, UpdatedAt: benchTime}
+			start := sqldriver.Snapshot()
+			if err := o.Insert(user); err != nil {
+				t.Fatalf("Insert failed: %v", err)
+			}
+			if _, err := o.GetByID(user.ID); err != nil {
+				t.Fatalf("GetByID failed: %v", err)
+			}
+			stats := sqldriver.Snapshot().Sub(start)
+			if stats.Queries() < 2 {
+				t.Errorf("counted %d queries for Insert + GetByID, want at least 2", stats.Queries())
+			}
+			if name == "stdlib" && stats.RoundTrips() != 2 {
+				t.Errorf("counted %d round trips for Insert + GetByID, want 2", stats.RoundTrips())
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"math"
+	"runtime/metrics"
+	"sync"
+	"testing"
+	"time"
+)
+
+// benchTracker 统计基准测试计时区间内的驱动调用和运行时指标
+type benchTracker struct {
+	queries *queryTracker
+	runtime *runtimeTracker
+}
+
+// trackBenchmark 开始统计，在重置计时器之后调用：
+//
+//	defer trackBenchmark(b).report()
+//
+// 放在 setup 返回的 cleanup 的 defer 之后，保证清理时的 DROP TABLE 等不计入
+func trackBenchmark(b *testing.B) *benchTracker {
+	return &benchTracker{queries: trackQueries(b), runtime: trackRuntime(b)}
+}
+
+// pause 暂停统计，与 b.StopTimer 配合排除计时区间外的调用。运行时指标无法扣除暂停区间，照常累计
+func (t *benchTracker) pause() {
+	t.queries.pause()
+}
+
+// resume 恢复统计
+func (t *benchTracker) resume() {
+	t.queries.resume()
+}
+
+// report 报告统计结果
+func (t *benchTracker) report() {
+	t.runtime.report()
+	t.queries.report()
+}
+
+// runtime/metrics 中使用的指标
+const (
+	metricGCCycles   = "/gc/cycles/total:gc-cycles"
+	metricGCCPU      = "/cpu/classes/gc/total:cpu-seconds"
+	metricTotalCPU   = "/cpu/classes/total:cpu-seconds"
+	metricGCPauses   = "/sched/pauses/total/gc:seconds"
+	metricGoroutines = "/sched/goroutines:goroutines"
+	metricHeap       = "/memory/classes/heap/objects:bytes"
+)
+
+// heapSampleInterval 采样堆大小以求峰值的间隔
+const heapSampleInterval = time.Millisecond
+
+// runtimeTracker 统计基准测试期间的 GC 次数、GC CPU 占比、停顿分布、堆峰值和 goroutine 数
+type runtimeTracker struct {
+	b     *testing.B
+	start []metrics.Sample
+	stop  chan struct{}
+	wg    sync.WaitGroup
+	peak  uint64
+}
+
+func readRuntimeMetrics() []metrics.Sample {
+	samples := []metrics.Sample{
+		{Name: metricGCCycles},
+		{Name: metricGCCPU},
+		{Name: metricTotalCPU},
+		{Name: metricGCPauses},
+		{Name: metricGoroutines},
+	}
+	metrics.Read(samples)
+	return samples
+}
+
+// trackRuntime 记录起始指标，并启动采样堆大小的 goroutine
+func trackRuntime(b *testing.B) *runtimeTracker {
+	r := &runtimeTracker{b: b, stop: make(chan struct{})}
+	r.start = readRuntimeMetrics()
+	r.wg.Add(1)
+	go r.sampleHeap()
+	return r
+}
+
+// sampleHeap 定期读取堆上对象的字节数，记录峰值。GC 之间的增长只能按采样间隔捕捉，峰值略偏低
+func (r *runtimeTracker) sampleHeap() {
+	defer r.wg.Done()
+	sample := []metrics.Sample{{Name: metricHeap}}
+	ticker := time.NewTicker(heapSampleInterval)
+	defer ticker.Stop()
+	for {
+		metrics.Read(sample)
+		r.peak = max(r.peak, sample[0].Value.Uint64())
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// report 报告 gc/op、gc-cpu-%、pause-p50-ns、pause-p99-ns、peak-heap-B 和 goroutines。
+// GC CPU 时间由运行时在每次 GC 结束时估算，GC 很少的基准测试中只是近似值
+func (r *runtimeTracker) report() {
+	close(r.stop)
+	r.wg.Wait()
+	end := readRuntimeMetrics()
+
+	n := float64(r.b.N)
+	r.b.ReportMetric(float64(end[0].Value.Uint64()-r.start[0].Value.Uint64())/n, "gc/op")
+	gcCPU := end[1].Value.Float64() - r.start[1].Value.Float64()
+	totalCPU := end[2].Value.Float64() - r.start[2].Value.Float64()
+	if totalCPU > 0 {
+		r.b.ReportMetric(100*gcCPU/totalCPU, "gc-cpu-%")
+	} else {
+		r.b.ReportMetric(0, "gc-cpu-%")
+	}
+	pauses := subHistogram(end[3].Value.Float64Histogram(), r.start[3].Value.Float64Histogram())
+	r.b.ReportMetric(quantile(pauses, 0.5)*1e9, "pause-p50-ns")
+	r.b.ReportMetric(quantile(pauses, 0.99)*1e9, "pause-p99-ns")
+	r.b.ReportMetric(float64(r.peak), "peak-heap-B")
+	r.b.ReportMetric(float64(end[4].Value.Uint64()), "goroutines")
+}
+
+// subHistogram 返回两次读取之间新增的计数
+func subHistogram(end, start *metrics.Float64Histogram) *metrics.Float64Histogram {
+	delta := &metrics.Float64Histogram{Buckets: end.Buckets, Counts: make([]uint64, len(end.Counts))}
+	for i := range end.Counts {
+		delta.Counts[i] = end.Counts[i] - start.Counts[i]
+	}
+	return delta
+}
+
+// quantile 返回直方图的 q 分位数，取所在桶的上界（最后一个桶取下界）；没有计数时返回 0
+func quantile(h *metrics.Float64Histogram, q float64) float64 {
+	var total uint64
+	for _, c := range h.Counts {
+		total += c
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := uint64(math.Ceil(q * float64(total)))
+	var seen uint64
+	for i, c := range h.Counts {
+		seen += c
+		if seen >= rank {
+			if upper := h.Buckets[i+1]; !math.IsInf(upper, 1) {
+				return upper
+			}
+			return h.Buckets[i]
+		}
+	}
+	return h.Buckets[len(h.Buckets)-1]
+}
+
+func TestQuantile(t *testing.T) {
+	h := &metrics.Float64Histogram{
+		Buckets: []float64{0, 1e-6, 1e-5, 1e-4, math.Inf(1)},
+		Counts:  []uint64{50, 40, 9, 1},
+	}
+	for _, tc := range []struct {
+		q    float64
+		want float64
+	}{
+		{0.5, 1e-6},
+		{0.9, 1e-5},
+		{0.99, 1e-4},
+		{1, 1e-4},
+	} {
+		if got := quantile(h, tc.q); got != tc.want {
+			t.Errorf("quantile(%v) = %v, want %v", tc.q, got, tc.want)
+		}
+	}
+	if got := quantile(&metrics.Float64Histogram{Buckets: h.Buckets, Counts: make([]uint64, 4)}, 0.5); got != 0 {
+		t.Errorf("quantile of empty histogram = %v, want 0", got)
+	}
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// softDeleteRows 软删除基准测试中表的行数（含已删除的行）
+const softDeleteRows = 2000
+
+// softDeletePercents 基准测试中已软删除的行所占的百分比
+var softDeletePercents = []int{0, 50, 90}
+
+// softDeleteRestoreBatch Delete 基准测试每删除这么多行后，停止计时恢复这些行，使删除比例基本不变
+const softDeleteRestoreBatch = 20
+
+// softDeleteFixture 软删除测试的表、直连同一数据库的连接，以及写入时未删除和已删除的 ID
+type softDeleteFixture struct {
+	s       orm.SoftDeleteInterface
+	db      *sql.DB
+	live    []int64
+	deleted []int64
+}
+
+// setupSoftDelete 初始化 ORM，经 ORM 写入 rows 行并软删除其中 percent% 的行，已删除的行均匀分布在表中
+func setupSoftDelete(tb testing.TB, ormName string, rows, percent int) (*softDeleteFixture, func()) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		tb.Skip("null driver returns canned results")
+	}
+	_, s, dsn, cleanup := setupCapabilityWithDSN(tb, ormName, orm.SoftDeleteInterface.CreateSoftUserTable, orm.SoftDeleteInterface.DropSoftUserTable)
+	// 用于检查存储内容和恢复被删除的行，不经过 ORM，也不计入驱动调用
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		cleanup()
+		tb.Fatalf("open %s: %v", dsn, err)
+	}
+	db.SetMaxOpenConns(1)
+	teardown := func() {
+		db.Close()
+		cleanup()
+	}
+
+	f := &softDeleteFixture{s: s, db: db}
+	for i := 0; i < rows; i++ {
+		user := newUser(i)
+		if err := s.InsertSoftUser(user); err != nil {
+			teardown()
+			tb.Fatalf("InsertSoftUser failed: %v", err)
+		}
+		if i%10 < percent/10 {
+			if err := s.DeleteSoftUser(user.ID); err != nil {
+				teardown()
+				tb.Fatalf("DeleteSoftUser failed: %v", err)
+			}
+			f.deleted = append(f.deleted, user.ID)
+		} else {
+			f.live = append(f.live, user.ID)
+		}
+	}
+	return f, teardown
+}
+
+// restore 清除 ids 的 deleted_at，恢复为未删除
+func (f *softDeleteFixture) restore(ids []int64) error {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	_, err := f.db.Exec("UPDATE soft_users SET deleted_at = NULL WHERE id IN (?"+strings.Repeat(", ?", len(ids)-1)+")", args...)
+	return err
+}
+
+// stored 返回表中的总行数和 deleted_at 非空的行数
+func (f *softDeleteFixture) stored() (total, deleted int64, err error) {
+	err = f.db.QueryRow("SELECT count(*), count(deleted_at) FROM soft_users").Scan(&total, &deleted)
+	return total, deleted, err
+}
+
+// TestSoftDelete 验证各实现的软删除一致：已删除的行从按 ID 查询、计数和分页中排除，
+// 但仍保留在表中；重复删除和删除不存在的 ID 不修改任何行
+func TestSoftDelete(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			f, cleanup := setupSoftDelete(t, ormName, 20, 30)
+			defer cleanup()
+
+			for _, id := range f.deleted {
+				if user, err := f.s.GetSoftUserByID(id); err == nil {
+					t.Errorf("GetSoftUserByID(%d) returned deleted user %+v", id, user)
+				}
+			}
+			for _, id := range f.live {
+				if _, err := f.s.GetSoftUserByID(id); err != nil {
+					t.Errorf("GetSoftUserByID(%d) failed: %v", id, err)
+				}
+			}
+
+			check := func(when string) {
+				t.Helper()
+				count, err := f.s.CountSoftUsers()
+				if err != nil {
+					t.Fatalf("CountSoftUsers failed: %v", err)
+				}
+				if count != int64(len(f.live)) {
+					t.Errorf("%s: CountSoftUsers = %d, want %d", when, count, len(f.live))
+				}
+				users, err := f.s.GetSoftUsers(100, 0)
+				if err != nil {
+					t.Fatalf("GetSoftUsers failed: %v", err)
+				}
+				if got := userIDs(users); !equalIDs(got, f.live) {
+					t.Errorf("%s: GetSoftUsers returned IDs %v, want %v", when, got, f.live)
+				}
+				// OFFSET 只跳过未删除的行
+				last, err := f.s.GetSoftUsers(100, len(f.live)-1)
+				if err != nil {
+					t.Fatalf("GetSoftUsers failed: %v", err)
+				}
+				if len(last) != 1 {
+					t.Errorf("%s: GetSoftUsers(100, %d) returned %d users, want 1", when, len(f.live)-1, len(last))
+				}
+				total, deleted, err := f.stored()
+				if err != nil {
+					t.Fatalf("read soft_users: %v", err)
+				}
+				if want := int64(len(f.live) + len(f.deleted)); total != want || deleted != int64(len(f.deleted)) {
+					t.Errorf("%s: soft_users has %d rows, %d deleted; want %d rows, %d deleted", when, total, deleted, want, len(f.deleted))
+				}
+			}
+			check("after DeleteSoftUser")
+
+			// 重复删除保留第一次删除的时间
+			var before, after sql.NullString
+			query := "SELECT deleted_at FROM soft_users WHERE id = ?"
+			if err := f.db.QueryRow(query, f.deleted[0]).Scan(&before); err != nil {
+				t.Fatalf("read deleted_at: %v", err)
+			}
+			if err := f.s.DeleteSoftUser(f.deleted[0]); err != nil {
+				t.Fatalf("DeleteSoftUser of deleted user failed: %v", err)
+			}
+			if err := f.db.QueryRow(query, f.deleted[0]).Scan(&after); err != nil {
+				t.Fatalf("read deleted_at: %v", err)
+			}
+			if after != before {
+				t.Errorf("deleting again changed deleted_at from %v to %v", before.String, after.String)
+			}
+			if err := f.s.DeleteSoftUser(1 << 40); err != nil {
+				t.Fatalf("DeleteSoftUser of missing user failed: %v", err)
+			}
+			check("after repeated DeleteSoftUser")
+
+			// 删除后再插入的行可见
+			user := newUser(100)
+			if err := f.s.InsertSoftUser(user); err != nil {
+				t.Fatalf("InsertSoftUser failed: %v", err)
+			}
+			f.live = append(f.live, user.ID)
+			got, err := f.s.GetSoftUserByID(user.ID)
+			if err != nil {
+				t.Fatalf("GetSoftUserByID failed: %v", err)
+			}
+			if got.Name != user.Name || got.Email != user.Email || got.Age != user.Age {
+				t.Errorf("GetSoftUserByID = %+v, want %+v", got, user)
+			}
+			check("after InsertSoftUser")
+		})
+	}
+}
+
+// softDeleteOps 软删除基准测试的操作。Delete 放在最后：它在计时外直接改表恢复被删除的行，
+// 会使带缓存的实现（xorm+cache）之后的查询读到过期结果
+var softDeleteOps = []string{"GetByID", "Count", "GetAll", "Delete"}
+
+// BenchmarkSoftDelete 已软删除 0%、50%、90% 的行时，Delete（软删除）、GetByID、Count 和 GetAll 的开销
+func BenchmarkSoftDelete_GORM(b *testing.B) {
+	benchmarkSoftDelete(b, "gorm")
+}
+
+func BenchmarkSoftDelete_XORM(b *testing.B) {
+	benchmarkSoftDelete(b, "xorm")
+}
+
+func BenchmarkSoftDelete_ZORM(b *testing.B) {
+	benchmarkSoftDelete(b, "zorm")
+}
+
+func BenchmarkSoftDelete_SQLX(b *testing.B) {
+	benchmarkSoftDelete(b, "sqlx")
+}
+
+func BenchmarkSoftDelete_BORM(b *testing.B) {
+	benchmarkSoftDelete(b, "borm")
+}
+
+func BenchmarkSoftDelete_BUN(b *testing.B) {
+	benchmarkSoftDelete(b, "bun")
+}
+
+func BenchmarkSoftDelete_ENT(b *testing.B) {
+	benchmarkSoftDelete(b, "ent")
+}
+
+func BenchmarkSoftDelete_STDLIB(b *testing.B) {
+	benchmarkSoftDelete(b, "stdlib")
+}
+
+func BenchmarkSoftDelete_DIRECT(b *testing.B) {
+	benchmarkSoftDelete(b, "direct")
+}
+
+func benchmarkSoftDelete(b *testing.B, ormName string) {
+	for _, percent := range softDeletePercents {
+		b.Run(fmt.Sprintf("deleted=%d%%", percent), func(b *testing.B) {
+			// 每个比例使用新的实例，Delete 恢复行后不再有查询
+			f, cleanup := setupSoftDelete(b, ormName, softDeleteRows, percent)
+			defer cleanup()
+
+			for _, op := range softDeleteOps {
+				b.Run(op, func(b *testing.B) {
+					limit := 100
+					pending := make([]int64, 0, softDeleteRestoreBatch)
+					b.ResetTimer()
+					b.ReportAllocs()
+					tracker := trackBenchmark(b)
+					defer tracker.report()
+
+					for i := 0; i < b.N; i++ {
+						id := f.live[i%len(f.live)]
+						var err error
+						switch op {
+						case "GetByID":
+							_, err = f.s.GetSoftUserByID(id)
+						case "Count":
+							_, err = f.s.CountSoftUsers()
+						case "GetAll":
+							_, err = f.s.GetSoftUsers(limit, (i*limit)%(len(f.live)-limit+1))
+						case "Delete":
+							err = f.s.DeleteSoftUser(id)
+							pending = append(pending, id)
+						}
+						if err != nil {
+							b.Fatalf("%s failed: %v", op, err)
+						}
+						if len(pending) == softDeleteRestoreBatch || (len(pending) > 0 && i == b.N-1) {
+							b.StopTimer()
+							tracker.pause()
+							if err := f.restore(pending); err != nil {
+								b.Fatalf("restore: %v", err)
+							}
+							pending = pending[:0]
+							tracker.resume()
+							b.StartTimer()
+						}
+					}
+				})
+			}
+		})
+	}
+}
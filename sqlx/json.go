@@ -0,0 +1,72 @@
+package sqlx
+
+import (
+	"database/sql"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (s *SqlxORM) CreateJSONTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS profiles (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			settings TEXT NOT NULL,
+			attrs TEXT NOT NULL
+		)
+	`)
+	return err
+}
+
+func (s *SqlxORM) DropJSONTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS profiles")
+	return err
+}
+
+func (s *SqlxORM) InsertProfile(profile *models.Profile) error {
+	query := `INSERT INTO profiles (name, settings, attrs) VALUES (?, ?, ?)`
+	result, err := s.db.Exec(query, profile.Name, models.JSON{V: &profile.Settings}, models.JSON{V: &profile.Attrs})
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	profile.ID = id
+	return nil
+}
+
+func (s *SqlxORM) GetProfileByID(id int64) (*models.Profile, error) {
+	profiles, err := s.queryProfiles("SELECT id, name, settings, attrs FROM profiles WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return profiles[0], nil
+}
+
+func (s *SqlxORM) GetProfilesByTheme(theme string) ([]*models.Profile, error) {
+	return s.queryProfiles("SELECT id, name, settings, attrs FROM profiles WHERE json_extract(settings, '$.theme') = ? ORDER BY id", theme)
+}
+
+// queryProfiles JSON 列无法通过 StructScan 映射，逐行 Scan 到 models.JSON
+func (s *SqlxORM) queryProfiles(query string, args ...interface{}) ([]*models.Profile, error) {
+	rows, err := s.db.Queryx(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*models.Profile
+	for rows.Next() {
+		var profile models.Profile
+		if err := rows.Scan(&profile.ID, &profile.Name, models.JSON{V: &profile.Settings}, models.JSON{V: &profile.Attrs}); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, &profile)
+	}
+	return profiles, rows.Err()
+}
@@ -0,0 +1,112 @@
+package sqlx
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
+
+// keyTableStatements uuid_users 和 snowflake_users 的建表语句
+var keyTableStatements = []string{
+	`
+		CREATE TABLE IF NOT EXISTS uuid_users (
+			id VARCHAR(36) PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_uuid_users_created_at ON uuid_users (created_at)`, `
+		CREATE TABLE IF NOT EXISTS snowflake_users (
+			id INTEGER PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_snowflake_users_created_at ON snowflake_users (created_at)`,
+}
+
+func (s *SqlxORM) CreateKeyTables() error {
+	for _, statement := range keyTableStatements {
+		if _, err := s.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SqlxORM) DropKeyTables() error {
+	if _, err := s.db.Exec("DROP TABLE IF EXISTS uuid_users"); err != nil {
+		return err
+	}
+	_, err := s.db.Exec("DROP TABLE IF EXISTS snowflake_users")
+	return err
+}
+
+func (s *SqlxORM) InsertUUIDUser(user *models.UUIDUser) error {
+	_, err := s.db.Exec(`INSERT INTO uuid_users (id, name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	return err
+}
+
+func (s *SqlxORM) InsertUUIDBatch(users []*models.UUIDUser) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Preparex(`INSERT INTO uuid_users (id, name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, user := range users {
+		if _, err := stmt.Exec(user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SqlxORM) GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error) {
+	user := &models.UUIDUser{}
+	err := s.db.Get(user, "SELECT id, name, email, age, created_at, updated_at FROM uuid_users WHERE id = ?", id)
+	return user, err
+}
+
+func (s *SqlxORM) InsertSnowflakeUser(user *models.SnowflakeUser) error {
+	_, err := s.db.Exec(`INSERT INTO snowflake_users (id, name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	return err
+}
+
+func (s *SqlxORM) InsertSnowflakeBatch(users []*models.SnowflakeUser) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Preparex(`INSERT INTO snowflake_users (id, name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, user := range users {
+		if _, err := stmt.Exec(user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *SqlxORM) GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error) {
+	user := &models.SnowflakeUser{}
+	err := s.db.Get(user, "SELECT id, name, email, age, created_at, updated_at FROM snowflake_users WHERE id = ?", id)
+	return user, err
+}
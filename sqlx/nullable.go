@@ -0,0 +1,58 @@
+package sqlx
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (s *SqlxORM) CreateNullableTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS nullable_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100),
+			email VARCHAR(100),
+			age INTEGER
+		)
+	`)
+	return err
+}
+
+func (s *SqlxORM) DropNullableTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS nullable_users")
+	return err
+}
+
+func (s *SqlxORM) InsertNullable(user *models.NullableUser) error {
+	query := `INSERT INTO nullable_users (name, email, age) VALUES (?, ?, ?)`
+	result, err := s.db.Exec(query, user.Name, user.Email, user.Age)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+func (s *SqlxORM) UpdateNullable(user *models.NullableUser) error {
+	query := `UPDATE nullable_users SET name = ?, email = ?, age = ? WHERE id = ?`
+	_, err := s.db.Exec(query, user.Name, user.Email, user.Age, user.ID)
+	return err
+}
+
+func (s *SqlxORM) GetNullableByID(id int64) (*models.NullableUser, error) {
+	user := &models.NullableUser{}
+	err := s.db.Get(user, "SELECT id, name, email, age FROM nullable_users WHERE id = ?", id)
+	return user, err
+}
+
+func (s *SqlxORM) GetNullableAll(limit, offset int) ([]*models.NullableUser, error) {
+	var users []*models.NullableUser
+	err := s.db.Select(&users, "SELECT id, name, email, age FROM nullable_users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	return users, err
+}
+
+func (s *SqlxORM) GetPlainAll(limit, offset int) ([]*models.PlainUser, error) {
+	var users []*models.PlainUser
+	err := s.db.Select(&users, "SELECT id, name, email, age FROM nullable_users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	return users, err
+}
@@ -0,0 +1,44 @@
+package sqlx
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (s *SqlxORM) CreatePostTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS posts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			title VARCHAR(200) NOT NULL,
+			body TEXT NOT NULL
+		)
+	`)
+	return err
+}
+
+func (s *SqlxORM) DropPostTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS posts")
+	return err
+}
+
+func (s *SqlxORM) InsertPost(post *models.Post) error {
+	result, err := s.db.Exec(`INSERT INTO posts (user_id, title, body) VALUES (?, ?, ?)`, post.UserID, post.Title, post.Body)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	post.ID = id
+	return nil
+}
+
+func (s *SqlxORM) GetPostByID(id int64) (*models.Post, error) {
+	post := &models.Post{}
+	err := s.db.Get(post, "SELECT id, user_id, title, body FROM posts WHERE id = ?", id)
+	return post, err
+}
+
+func (s *SqlxORM) DeletePost(id int64) error {
+	_, err := s.db.Exec("DELETE FROM posts WHERE id = ?", id)
+	return err
+}
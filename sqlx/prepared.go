@@ -0,0 +1,130 @@
+package sqlx
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/jmoiron/sqlx"
+)
+
+// PreparedSqlxORM 在 SqlxORM 基础上使用 Preparex/PrepareNamed 预编译的语句执行常用操作，
+// 未覆盖的方法沿用 SqlxORM 的实现
+type PreparedSqlxORM struct {
+	*SqlxORM
+
+	insert  *sqlx.NamedStmt
+	update  *sqlx.NamedStmt
+	getByID *sqlx.Stmt
+	del     *sqlx.Stmt
+	count   *sqlx.Stmt
+	getAll  *sqlx.Stmt
+}
+
+// NewPrepared 创建使用预编译语句的实现，语句在 CreateTable 之后编译
+func NewPrepared() *PreparedSqlxORM {
+	return &PreparedSqlxORM{SqlxORM: New()}
+}
+
+func (s *PreparedSqlxORM) CreateTable() error {
+	if err := s.SqlxORM.CreateTable(); err != nil {
+		return err
+	}
+	if s.insert != nil {
+		return nil
+	}
+
+	var err error
+	if s.insert, err = s.db.PrepareNamed(`INSERT INTO users (name, email, age, created_at, updated_at) VALUES (:name, :email, :age, :created_at, :updated_at)`); err != nil {
+		return err
+	}
+	if s.update, err = s.db.PrepareNamed(`UPDATE users SET name = :name, email = :email, age = :age, created_at = :created_at, updated_at = :updated_at WHERE id = :id`); err != nil {
+		return err
+	}
+	if s.getByID, err = s.db.Preparex("SELECT id, name, email, age, created_at, updated_at FROM users WHERE id = ?"); err != nil {
+		return err
+	}
+	if s.del, err = s.db.Preparex("DELETE FROM users WHERE id = ?"); err != nil {
+		return err
+	}
+	if s.count, err = s.db.Preparex("SELECT COUNT(*) FROM users"); err != nil {
+		return err
+	}
+	s.getAll, err = s.db.Preparex("SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?")
+	return err
+}
+
+func (s *PreparedSqlxORM) Close() error {
+	for _, stmt := range []*sqlx.NamedStmt{s.insert, s.update} {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}
+	for _, stmt := range []*sqlx.Stmt{s.getByID, s.del, s.count, s.getAll} {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}
+	return s.SqlxORM.Close()
+}
+
+func (s *PreparedSqlxORM) Insert(user *models.User) error {
+	result, err := s.insert.Exec(user.InUTC())
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+func (s *PreparedSqlxORM) InsertBatch(users []*models.User) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 在事务内复用已预编译的 NamedStmt
+	stmt := tx.NamedStmt(s.insert)
+	for _, user := range users {
+		result, err := stmt.Exec(user.InUTC())
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		user.ID = id
+	}
+	return tx.Commit()
+}
+
+func (s *PreparedSqlxORM) GetByID(id int64) (*models.User, error) {
+	user := &models.User{}
+	err := s.getByID.Get(user, id)
+	return user, err
+}
+
+func (s *PreparedSqlxORM) Update(user *models.User) error {
+	_, err := s.update.Exec(user.InUTC())
+	return err
+}
+
+func (s *PreparedSqlxORM) Delete(id int64) error {
+	_, err := s.del.Exec(id)
+	return err
+}
+
+func (s *PreparedSqlxORM) Count() (int64, error) {
+	var count int64
+	err := s.count.Get(&count)
+	return count, err
+}
+
+func (s *PreparedSqlxORM) GetAll(limit, offset int) ([]*models.User, error) {
+	var users []*models.User
+	err := s.getAll.Select(&users, limit, offset)
+	return users, err
+}
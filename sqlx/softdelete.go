@@ -0,0 +1,73 @@
+package sqlx
+
+import (
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (s *SqlxORM) CreateSoftUserTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS soft_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME
+		)
+	`)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_soft_users_created_at ON soft_users (created_at)`)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_soft_users_deleted_at ON soft_users (deleted_at)`)
+	return err
+}
+
+func (s *SqlxORM) DropSoftUserTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS soft_users")
+	return err
+}
+
+func (s *SqlxORM) InsertSoftUser(user *models.User) error {
+	result, err := s.db.Exec(`INSERT INTO soft_users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+// DeleteSoftUser 已删除的记录不再更新，保留第一次删除的时间
+func (s *SqlxORM) DeleteSoftUser(id int64) error {
+	_, err := s.db.Exec("UPDATE soft_users SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL", time.Now().UTC(), id)
+	return err
+}
+
+func (s *SqlxORM) GetSoftUserByID(id int64) (*models.User, error) {
+	user := &models.User{}
+	err := s.db.Get(user, "SELECT id, name, email, age, created_at, updated_at FROM soft_users WHERE id = ? AND deleted_at IS NULL", id)
+	return user, err
+}
+
+func (s *SqlxORM) CountSoftUsers() (int64, error) {
+	var count int64
+	err := s.db.Get(&count, "SELECT COUNT(*) FROM soft_users WHERE deleted_at IS NULL")
+	return count, err
+}
+
+func (s *SqlxORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	var users []*models.User
+	err := s.db.Select(&users, "SELECT id, name, email, age, created_at, updated_at FROM soft_users WHERE deleted_at IS NULL ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	return users, err
+}
@@ -2,23 +2,31 @@ package sqlx
 
 import (
 	"os"
+	"time"
 
 	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/sqldriver"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/mattn/go-sqlite3"
 )
 
 type SqlxORM struct {
 	db *sqlx.DB
 }
 
+func init() {
+	// sqlx 按驱动名决定占位符，包装的驱动沿用 sqlite3 的 ?
+	for _, name := range sqldriver.Names() {
+		sqlx.BindDriver(name, sqlx.QUESTION)
+	}
+}
+
 func New() *SqlxORM {
 	return &SqlxORM{}
 }
 
 func (s *SqlxORM) Init(dsn string) error {
 	var err error
-	s.db, err = sqlx.Connect("sqlite3", dsn)
+	s.db, err = sqlx.Connect(sqldriver.Name(), dsn)
 	return err
 }
 
@@ -33,23 +41,22 @@ func (s *SqlxORM) CreateTable() error {
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			name VARCHAR(100) NOT NULL,
 			email VARCHAR(100) NOT NULL,
-			age INTEGER NOT NULL
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
 		)
 	`)
 	if err != nil {
 		return err
 	}
 
+	_, err = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at)`)
+	if err != nil {
+		return err
+	}
+
 	// 创建 posts 表
-	_, err = s.db.Exec(`
-		CREATE TABLE IF NOT EXISTS posts (
-			id INTEGER PRIMARY KEY AUTOINCREMENT,
-			user_id INTEGER NOT NULL,
-			title VARCHAR(200) NOT NULL,
-			body TEXT NOT NULL
-		)
-	`)
-	return err
+	return s.CreatePostTable()
 }
 
 func (s *SqlxORM) DropTable() error {
@@ -57,13 +64,12 @@ func (s *SqlxORM) DropTable() error {
 	if err != nil {
 		return err
 	}
-	_, err = s.db.Exec("DROP TABLE IF EXISTS posts")
-	return err
+	return s.DropPostTable()
 }
 
 func (s *SqlxORM) Insert(user *models.User) error {
-	query := `INSERT INTO users (name, email, age) VALUES (?, ?, ?)`
-	result, err := s.db.Exec(query, user.Name, user.Email, user.Age)
+	query := `INSERT INTO users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`
+	result, err := s.db.Exec(query, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
 	if err != nil {
 		return err
 	}
@@ -76,7 +82,7 @@ func (s *SqlxORM) Insert(user *models.User) error {
 }
 
 func (s *SqlxORM) InsertBatch(users []*models.User) error {
-	query := `INSERT INTO users (name, email, age) VALUES (?, ?, ?)`
+	query := `INSERT INTO users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`
 	tx, err := s.db.Beginx()
 	if err != nil {
 		return err
@@ -90,7 +96,7 @@ func (s *SqlxORM) InsertBatch(users []*models.User) error {
 	defer stmt.Close()
 
 	for _, user := range users {
-		result, err := stmt.Exec(user.Name, user.Email, user.Age)
+		result, err := stmt.Exec(user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
 		if err != nil {
 			return err
 		}
@@ -105,12 +111,12 @@ func (s *SqlxORM) InsertBatch(users []*models.User) error {
 
 func (s *SqlxORM) GetByID(id int64) (*models.User, error) {
 	user := &models.User{}
-	err := s.db.Get(user, "SELECT id, name, email, age FROM users WHERE id = ?", id)
+	err := s.db.Get(user, "SELECT id, name, email, age, created_at, updated_at FROM users WHERE id = ?", id)
 	return user, err
 }
 
 func (s *SqlxORM) GetByIDs(ids []int64) ([]*models.User, error) {
-	query, args, err := sqlx.In("SELECT id, name, email, age FROM users WHERE id IN (?)", ids)
+	query, args, err := sqlx.In("SELECT id, name, email, age, created_at, updated_at FROM users WHERE id IN (?)", ids)
 	if err != nil {
 		return nil, err
 	}
@@ -122,8 +128,8 @@ func (s *SqlxORM) GetByIDs(ids []int64) ([]*models.User, error) {
 }
 
 func (s *SqlxORM) Update(user *models.User) error {
-	query := `UPDATE users SET name = ?, email = ?, age = ? WHERE id = ?`
-	_, err := s.db.Exec(query, user.Name, user.Email, user.Age, user.ID)
+	query := `UPDATE users SET name = ?, email = ?, age = ?, created_at = ?, updated_at = ? WHERE id = ?`
+	_, err := s.db.Exec(query, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC(), user.ID)
 	return err
 }
 
@@ -140,10 +146,41 @@ func (s *SqlxORM) Count() (int64, error) {
 
 func (s *SqlxORM) GetAll(limit, offset int) ([]*models.User, error) {
 	var users []*models.User
-	err := s.db.Select(&users, "SELECT id, name, email, age FROM users LIMIT ? OFFSET ?", limit, offset)
+	err := s.db.Select(&users, "SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	return users, err
+}
+
+func (s *SqlxORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	var users []*models.User
+	err := s.db.Select(&users, "SELECT id, name, email, age, created_at, updated_at FROM users WHERE created_at >= ? AND created_at < ? ORDER BY created_at", from.UTC(), to.UTC())
 	return users, err
 }
 
+func (s *SqlxORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	var users []*models.User
+	err := s.db.Select(&users, query, args...)
+	return users, err
+}
+
+func (s *SqlxORM) Iterate(fn func(*models.User) error) error {
+	rows, err := s.db.Queryx("SELECT id, name, email, age, created_at, updated_at FROM users")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user models.User
+		if err := rows.StructScan(&user); err != nil {
+			return err
+		}
+		if err := fn(&user); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 // GetDSN 生成测试用的 DSN
 func GetDSN() string {
 	return getTempFile()
@@ -0,0 +1,128 @@
+package sqlx
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// tagTableStatements tags 和 user_tags 的建表语句
+var tagTableStatements = []string{
+	`
+		CREATE TABLE IF NOT EXISTS tags (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL UNIQUE
+		)
+	`, `
+		CREATE TABLE IF NOT EXISTS user_tags (
+			user_id INTEGER NOT NULL,
+			tag_id INTEGER NOT NULL,
+			PRIMARY KEY (user_id, tag_id)
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_user_tags_tag_id ON user_tags (tag_id)`,
+}
+
+// usersWithTagsQuery 分页查询用户并左连接其标签，每个用户的行相邻，没有标签的用户只有一行且标签列为 NULL
+const usersWithTagsQuery = `
+	SELECT u.id, u.name, u.email, u.age, u.created_at, u.updated_at, t.id AS tag_id, t.name AS tag_name
+	FROM (SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?) AS u
+	LEFT JOIN user_tags AS ut ON ut.user_id = u.id
+	LEFT JOIN tags AS t ON t.id = ut.tag_id
+	ORDER BY u.id, t.id
+`
+
+// taggedUserRow usersWithTagsQuery 的一行
+type taggedUserRow struct {
+	models.User
+	TagID   sql.NullInt64  `db:"tag_id"`
+	TagName sql.NullString `db:"tag_name"`
+}
+
+func (s *SqlxORM) CreateTagTables() error {
+	for _, statement := range tagTableStatements {
+		if _, err := s.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SqlxORM) DropTagTables() error {
+	if _, err := s.db.Exec("DROP TABLE IF EXISTS user_tags"); err != nil {
+		return err
+	}
+	_, err := s.db.Exec("DROP TABLE IF EXISTS tags")
+	return err
+}
+
+func (s *SqlxORM) InsertTags(tags []*models.Tag) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Preparex(`INSERT INTO tags (name) VALUES (?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, tag := range tags {
+		result, err := stmt.Exec(tag.Name)
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		tag.ID = id
+	}
+	return tx.Commit()
+}
+
+func (s *SqlxORM) AttachTags(userID int64, tagIDs []int64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(tagIDs)*2)
+	for _, tagID := range tagIDs {
+		args = append(args, userID, tagID)
+	}
+	_, err := s.db.Exec("INSERT INTO user_tags (user_id, tag_id) VALUES (?, ?)"+strings.Repeat(", (?, ?)", len(tagIDs)-1)+" ON CONFLICT DO NOTHING", args...)
+	return err
+}
+
+func (s *SqlxORM) GetUsersWithTags(limit, offset int) ([]*models.TaggedUser, error) {
+	var rows []taggedUserRow
+	if err := s.db.Select(&rows, usersWithTagsQuery, limit, offset); err != nil {
+		return nil, err
+	}
+
+	users := make([]*models.TaggedUser, 0, limit)
+	for _, row := range rows {
+		if len(users) == 0 || users[len(users)-1].ID != row.ID {
+			users = append(users, &models.TaggedUser{User: row.User})
+		}
+		if row.TagID.Valid {
+			user := users[len(users)-1]
+			user.Tags = append(user.Tags, &models.Tag{ID: row.TagID.Int64, Name: row.TagName.String})
+		}
+	}
+	return users, nil
+}
+
+func (s *SqlxORM) GetUsersByTag(name string) ([]*models.User, error) {
+	var users []*models.User
+	err := s.db.Select(&users, `
+		SELECT users.id, users.name, users.email, users.age, users.created_at, users.updated_at
+		FROM users
+		JOIN user_tags ON user_tags.user_id = users.id
+		JOIN tags ON tags.id = user_tags.tag_id
+		WHERE tags.name = ?
+		ORDER BY users.id
+	`, name)
+	return users, err
+}
@@ -0,0 +1,62 @@
+package sqlx
+
+import (
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+func (s *SqlxORM) CreateVersionedTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS versioned_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			version INTEGER NOT NULL
+		)
+	`)
+	return err
+}
+
+func (s *SqlxORM) DropVersionedTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS versioned_users")
+	return err
+}
+
+func (s *SqlxORM) InsertVersioned(user *models.VersionedUser) error {
+	result, err := s.db.Exec(`INSERT INTO versioned_users (name, email, age, version) VALUES (?, ?, ?, 1)`,
+		user.Name, user.Email, user.Age)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	user.Version = 1
+	return nil
+}
+
+func (s *SqlxORM) UpdateIfVersion(user *models.VersionedUser, expectedVersion int64) error {
+	result, err := s.db.Exec(`UPDATE versioned_users SET name = ?, email = ?, age = ?, version = version + 1 WHERE id = ? AND version = ?`,
+		user.Name, user.Email, user.Age, user.ID, expectedVersion)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return orm.ErrVersionConflict
+	}
+	user.Version = expectedVersion + 1
+	return nil
+}
+
+func (s *SqlxORM) GetVersionedByID(id int64) (*models.VersionedUser, error) {
+	user := &models.VersionedUser{}
+	err := s.db.Get(user, "SELECT id, name, email, age, version FROM versioned_users WHERE id = ?", id)
+	return user, err
+}
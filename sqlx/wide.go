@@ -0,0 +1,40 @@
+package sqlx
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (s *SqlxORM) CreateWideTables() error {
+	for _, n := range models.WideWidths {
+		if _, err := s.db.Exec(models.WideDDL(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SqlxORM) DropWideTables() error {
+	for _, n := range models.WideWidths {
+		if _, err := s.db.Exec("DROP TABLE IF EXISTS " + models.NewWide(n).TableName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SqlxORM) InsertWide(row models.Wide) error {
+	result, err := s.db.Exec(models.WideInsertSQL(len(row.Columns())), row.Values()...)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	row.SetID(id)
+	return nil
+}
+
+func (s *SqlxORM) GetWide(cols, limit, offset int) ([]models.Wide, error) {
+	rows := models.NewWideSlice(cols)
+	err := s.db.Select(rows, models.WideSelectSQL(cols), limit, offset)
+	return models.WideRows(rows), err
+}
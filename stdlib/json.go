@@ -0,0 +1,66 @@
+package stdlib
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (s *StdlibORM) CreateJSONTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS profiles (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			settings TEXT NOT NULL,
+			attrs TEXT NOT NULL
+		)
+	`)
+	return err
+}
+
+func (s *StdlibORM) DropJSONTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS profiles")
+	return err
+}
+
+func (s *StdlibORM) InsertProfile(profile *models.Profile) error {
+	id, err := s.insert(`INSERT INTO profiles (name, settings, attrs) VALUES (?, ?, ?)`,
+		profile.Name, models.JSON{V: &profile.Settings}, models.JSON{V: &profile.Attrs})
+	if err != nil {
+		return err
+	}
+	profile.ID = id
+	return nil
+}
+
+func (s *StdlibORM) GetProfileByID(id int64) (*models.Profile, error) {
+	profile := &models.Profile{}
+	err := s.queryRow([]interface{}{&profile.ID, &profile.Name, models.JSON{V: &profile.Settings}, models.JSON{V: &profile.Attrs}},
+		"SELECT id, name, settings, attrs FROM profiles WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("profile not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (s *StdlibORM) GetProfilesByTheme(theme string) ([]*models.Profile, error) {
+	rows, err := s.query("SELECT id, name, settings, attrs FROM profiles WHERE json_extract(settings, '$.theme') = ? ORDER BY id", theme)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*models.Profile
+	for rows.Next() {
+		var profile models.Profile
+		if err := rows.Scan(&profile.ID, &profile.Name, models.JSON{V: &profile.Settings}, models.JSON{V: &profile.Attrs}); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, &profile)
+	}
+	return profiles, rows.Err()
+}
@@ -0,0 +1,124 @@
+package stdlib
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
+
+func (s *StdlibORM) CreateKeyTables() error {
+	statements := []string{`
+		CREATE TABLE IF NOT EXISTS uuid_users (
+			id VARCHAR(36) PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_uuid_users_created_at ON uuid_users (created_at)`, `
+		CREATE TABLE IF NOT EXISTS snowflake_users (
+			id INTEGER PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_snowflake_users_created_at ON snowflake_users (created_at)`}
+	for _, statement := range statements {
+		if _, err := s.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *StdlibORM) DropKeyTables() error {
+	if _, err := s.db.Exec("DROP TABLE IF EXISTS uuid_users"); err != nil {
+		return err
+	}
+	_, err := s.db.Exec("DROP TABLE IF EXISTS snowflake_users")
+	return err
+}
+
+func (s *StdlibORM) InsertUUIDUser(user *models.UUIDUser) error {
+	_, err := s.exec(`INSERT INTO uuid_users (id, name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	return err
+}
+
+func (s *StdlibORM) InsertUUIDBatch(users []*models.UUIDUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	// 单条多行 INSERT
+	var query strings.Builder
+	query.WriteString(`INSERT INTO uuid_users (id, name, email, age, created_at, updated_at) VALUES `)
+	args := make([]interface{}, 0, len(users)*6)
+	for i, user := range users {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?, ?)")
+		args = append(args, user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	}
+	_, err := s.exec(query.String(), args...)
+	return err
+}
+
+func (s *StdlibORM) GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error) {
+	user := &models.UUIDUser{}
+	err := s.queryRow([]interface{}{&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt},
+		"SELECT "+userColumns+" FROM uuid_users WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *StdlibORM) InsertSnowflakeUser(user *models.SnowflakeUser) error {
+	_, err := s.exec(`INSERT INTO snowflake_users (id, name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	return err
+}
+
+func (s *StdlibORM) InsertSnowflakeBatch(users []*models.SnowflakeUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	// 单条多行 INSERT
+	var query strings.Builder
+	query.WriteString(`INSERT INTO snowflake_users (id, name, email, age, created_at, updated_at) VALUES `)
+	args := make([]interface{}, 0, len(users)*6)
+	for i, user := range users {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?, ?)")
+		args = append(args, user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	}
+	_, err := s.exec(query.String(), args...)
+	return err
+}
+
+func (s *StdlibORM) GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error) {
+	user := &models.SnowflakeUser{}
+	err := s.queryRow([]interface{}{&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt},
+		"SELECT "+userColumns+" FROM snowflake_users WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
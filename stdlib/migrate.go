@@ -0,0 +1,61 @@
+package stdlib
+
+import (
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+const createMigrateTable = `
+	CREATE TABLE %s (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name VARCHAR(%d) NOT NULL,
+		email VARCHAR(100) NOT NULL,
+		age INTEGER NOT NULL
+	)
+`
+
+func (s *StdlibORM) CreateMigrateTable() error {
+	_, err := s.db.Exec(fmt.Sprintf(createMigrateTable, models.MigrateTable, 50))
+	return err
+}
+
+func (s *StdlibORM) DropMigrateTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS " + models.MigrateTable)
+	return err
+}
+
+// Migrate 手写 DDL。SQLite 不支持修改列类型，加宽列按官方建议的步骤在事务中重建表
+func (s *StdlibORM) Migrate(step models.MigrateStep) error {
+	switch step {
+	case models.MigrateAddColumn:
+		_, err := s.db.Exec("ALTER TABLE migrate_users ADD COLUMN nickname VARCHAR(50)")
+		return err
+	case models.MigrateAddIndex:
+		_, err := s.db.Exec("CREATE INDEX idx_migrate_users_email ON migrate_users (email)")
+		return err
+	case models.MigrateWidenColumn:
+		return s.widenMigrateName()
+	}
+	return fmt.Errorf("unknown migration step: %v", step)
+}
+
+// widenMigrateName 新建加宽后的表，复制数据后替换原表
+func (s *StdlibORM) widenMigrateName() error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range []string{
+		fmt.Sprintf(createMigrateTable, "migrate_users_new", 200),
+		"INSERT INTO migrate_users_new (id, name, email, age) SELECT id, name, email, age FROM migrate_users",
+		"DROP TABLE migrate_users",
+		"ALTER TABLE migrate_users_new RENAME TO migrate_users",
+	} {
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
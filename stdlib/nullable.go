@@ -0,0 +1,88 @@
+package stdlib
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (s *StdlibORM) CreateNullableTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS nullable_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100),
+			email VARCHAR(100),
+			age INTEGER
+		)
+	`)
+	return err
+}
+
+func (s *StdlibORM) DropNullableTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS nullable_users")
+	return err
+}
+
+func (s *StdlibORM) InsertNullable(user *models.NullableUser) error {
+	id, err := s.insert(`INSERT INTO nullable_users (name, email, age) VALUES (?, ?, ?)`, user.Name, user.Email, user.Age)
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+func (s *StdlibORM) UpdateNullable(user *models.NullableUser) error {
+	_, err := s.exec(`UPDATE nullable_users SET name = ?, email = ?, age = ? WHERE id = ?`, user.Name, user.Email, user.Age, user.ID)
+	return err
+}
+
+func (s *StdlibORM) GetNullableByID(id int64) (*models.NullableUser, error) {
+	user := &models.NullableUser{}
+	err := s.queryRow([]interface{}{&user.ID, &user.Name, &user.Email, &user.Age},
+		"SELECT id, name, email, age FROM nullable_users WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *StdlibORM) GetNullableAll(limit, offset int) ([]*models.NullableUser, error) {
+	rows, err := s.query("SELECT id, name, email, age FROM nullable_users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*models.NullableUser, 0, limit)
+	for rows.Next() {
+		var user models.NullableUser
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (s *StdlibORM) GetPlainAll(limit, offset int) ([]*models.PlainUser, error) {
+	rows, err := s.query("SELECT id, name, email, age FROM nullable_users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*models.PlainUser, 0, limit)
+	for rows.Next() {
+		var user models.PlainUser
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,52 @@
+package stdlib
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (s *StdlibORM) CreatePostTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS posts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NOT NULL,
+			title VARCHAR(200) NOT NULL,
+			body TEXT NOT NULL
+		)
+	`)
+	return err
+}
+
+func (s *StdlibORM) DropPostTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS posts")
+	return err
+}
+
+func (s *StdlibORM) InsertPost(post *models.Post) error {
+	id, err := s.insert(`INSERT INTO posts (user_id, title, body) VALUES (?, ?, ?)`, post.UserID, post.Title, post.Body)
+	if err != nil {
+		return err
+	}
+	post.ID = id
+	return nil
+}
+
+func (s *StdlibORM) GetPostByID(id int64) (*models.Post, error) {
+	post := &models.Post{}
+	err := s.queryRow([]interface{}{&post.ID, &post.UserID, &post.Title, &post.Body},
+		"SELECT id, user_id, title, body FROM posts WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("post not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (s *StdlibORM) DeletePost(id int64) error {
+	_, err := s.exec("DELETE FROM posts WHERE id = ?", id)
+	return err
+}
@@ -0,0 +1,79 @@
+package stdlib
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (s *StdlibORM) CreateSoftUserTable() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS soft_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME
+		)
+	`)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_soft_users_created_at ON soft_users (created_at)`)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_soft_users_deleted_at ON soft_users (deleted_at)`)
+	return err
+}
+
+func (s *StdlibORM) DropSoftUserTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS soft_users")
+	return err
+}
+
+func (s *StdlibORM) InsertSoftUser(user *models.User) error {
+	id, err := s.insert(`INSERT INTO soft_users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+// DeleteSoftUser 已删除的记录不再更新，保留第一次删除的时间
+func (s *StdlibORM) DeleteSoftUser(id int64) error {
+	_, err := s.exec("UPDATE soft_users SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL", time.Now().UTC(), id)
+	return err
+}
+
+func (s *StdlibORM) GetSoftUserByID(id int64) (*models.User, error) {
+	user := &models.User{}
+	err := s.queryRow(userPointers(user), "SELECT "+userColumns+" FROM soft_users WHERE id = ? AND deleted_at IS NULL", id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *StdlibORM) CountSoftUsers() (int64, error) {
+	var count int64
+	err := s.queryRow([]interface{}{&count}, "SELECT COUNT(*) FROM soft_users WHERE deleted_at IS NULL")
+	return count, err
+}
+
+func (s *StdlibORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	rows, err := s.query("SELECT "+userColumns+" FROM soft_users WHERE deleted_at IS NULL ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanUsers(rows, limit)
+}
@@ -0,0 +1,308 @@
+// Package stdlib 只使用 database/sql 的基准实现，作为各 ORM 开销的参照下限。
+package stdlib
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// StdlibORM 手写 SQL + database/sql，不做任何额外抽象。
+// prepared 为 true 时每条 SQL 首次执行时预编译并缓存，之后复用 *sql.Stmt。
+type StdlibORM struct {
+	db       *sql.DB
+	prepared bool
+
+	mu    sync.Mutex
+	stmts map[string]*sql.Stmt
+}
+
+// New 创建直接执行 SQL 的实现
+func New() *StdlibORM {
+	return &StdlibORM{}
+}
+
+// NewPrepared 创建使用预编译语句的实现
+func NewPrepared() *StdlibORM {
+	return &StdlibORM{prepared: true, stmts: make(map[string]*sql.Stmt)}
+}
+
+func (s *StdlibORM) Init(dsn string) error {
+	var err error
+	s.db, err = sql.Open(sqldriver.Name(), dsn)
+	if err != nil {
+		return err
+	}
+	// 共享缓存的内存库中连接之间按表加锁，冲突时立即返回 SQLITE_LOCKED，不等待 busy_timeout，限制为单连接
+	s.db.SetMaxOpenConns(1)
+	return nil
+}
+
+func (s *StdlibORM) Close() error {
+	s.mu.Lock()
+	for _, stmt := range s.stmts {
+		stmt.Close()
+	}
+	s.stmts = nil
+	s.mu.Unlock()
+	return s.db.Close()
+}
+
+// stmt 返回 query 对应的预编译语句，首次使用时编译并缓存
+func (s *StdlibORM) stmt(query string) (*sql.Stmt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stmt, ok := s.stmts[query]; ok {
+		return stmt, nil
+	}
+	stmt, err := s.db.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	s.stmts[query] = stmt
+	return stmt, nil
+}
+
+func (s *StdlibORM) exec(query string, args ...interface{}) (sql.Result, error) {
+	if !s.prepared {
+		return s.db.Exec(query, args...)
+	}
+	stmt, err := s.stmt(query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.Exec(args...)
+}
+
+func (s *StdlibORM) query(query string, args ...interface{}) (*sql.Rows, error) {
+	if !s.prepared {
+		return s.db.Query(query, args...)
+	}
+	stmt, err := s.stmt(query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.Query(args...)
+}
+
+// queryRow 查询单行并扫描到 dest，无结果时返回 sql.ErrNoRows
+func (s *StdlibORM) queryRow(dest []interface{}, query string, args ...interface{}) error {
+	if !s.prepared {
+		return s.db.QueryRow(query, args...).Scan(dest...)
+	}
+	stmt, err := s.stmt(query)
+	if err != nil {
+		return err
+	}
+	return stmt.QueryRow(args...).Scan(dest...)
+}
+
+// insert 执行插入并返回自增主键
+func (s *StdlibORM) insert(query string, args ...interface{}) (int64, error) {
+	result, err := s.exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+func (s *StdlibORM) CreateTable() error {
+	// 创建 users 表
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at)`)
+	if err != nil {
+		return err
+	}
+
+	// 创建 posts 表
+	return s.CreatePostTable()
+}
+
+func (s *StdlibORM) DropTable() error {
+	_, err := s.db.Exec("DROP TABLE IF EXISTS users")
+	if err != nil {
+		return err
+	}
+	return s.DropPostTable()
+}
+
+const userColumns = "id, name, email, age, created_at, updated_at"
+
+func userPointers(user *models.User) []interface{} {
+	return []interface{}{&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt}
+}
+
+// scanUsers 读取 rows 中的所有用户并关闭 rows
+func scanUsers(rows *sql.Rows, capacity int) ([]*models.User, error) {
+	defer rows.Close()
+
+	users := make([]*models.User, 0, capacity)
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(userPointers(&user)...); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (s *StdlibORM) Insert(user *models.User) error {
+	id, err := s.insert(`INSERT INTO users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+func (s *StdlibORM) InsertBatch(users []*models.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	// 单条多行 INSERT
+	var query strings.Builder
+	query.WriteString(`INSERT INTO users (name, email, age, created_at, updated_at) VALUES `)
+	args := make([]interface{}, 0, len(users)*5)
+	for i, user := range users {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?)")
+		args = append(args, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	}
+
+	lastID, err := s.insert(query.String(), args...)
+	if err != nil {
+		return err
+	}
+
+	// 多行插入时 last_insert_rowid 为最后一行的 ID，单语句内自增 ID 连续
+	firstID := lastID - int64(len(users)) + 1
+	for i, user := range users {
+		user.ID = firstID + int64(i)
+	}
+	return nil
+}
+
+func (s *StdlibORM) GetByID(id int64) (*models.User, error) {
+	user := &models.User{}
+	err := s.queryRow(userPointers(user), "SELECT "+userColumns+" FROM users WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *StdlibORM) GetByIDs(ids []int64) ([]*models.User, error) {
+	if len(ids) == 0 {
+		return []*models.User{}, nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	query := "SELECT " + userColumns + " FROM users WHERE id IN (?" + strings.Repeat(", ?", len(ids)-1) + ")"
+
+	rows, err := s.query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanUsers(rows, len(ids))
+}
+
+func (s *StdlibORM) Update(user *models.User) error {
+	_, err := s.exec(`UPDATE users SET name = ?, email = ?, age = ?, created_at = ?, updated_at = ? WHERE id = ?`,
+		user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC(), user.ID)
+	return err
+}
+
+func (s *StdlibORM) Delete(id int64) error {
+	_, err := s.exec("DELETE FROM users WHERE id = ?", id)
+	return err
+}
+
+func (s *StdlibORM) Count() (int64, error) {
+	var count int64
+	err := s.queryRow([]interface{}{&count}, "SELECT COUNT(*) FROM users")
+	return count, err
+}
+
+func (s *StdlibORM) GetAll(limit, offset int) ([]*models.User, error) {
+	rows, err := s.query("SELECT "+userColumns+" FROM users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanUsers(rows, limit)
+}
+
+func (s *StdlibORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	rows, err := s.query("SELECT "+userColumns+" FROM users WHERE created_at >= ? AND created_at < ? ORDER BY created_at", from.UTC(), to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	return scanUsers(rows, 0)
+}
+
+func (s *StdlibORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	rows, err := s.query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanUsers(rows, 0)
+}
+
+func (s *StdlibORM) Iterate(fn func(*models.User) error) error {
+	rows, err := s.query("SELECT " + userColumns + " FROM users")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(userPointers(&user)...); err != nil {
+			return err
+		}
+		if err := fn(&user); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// GetDSN 生成测试用的 DSN
+func GetDSN() string {
+	return fmt.Sprintf("file:%s?cache=shared&mode=memory", getTempFile())
+}
+
+func getTempFile() string {
+	tmpfile, _ := os.CreateTemp("", "stdlib_*.db")
+	tmpfile.Close()
+	return tmpfile.Name()
+}
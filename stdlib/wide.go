@@ -0,0 +1,48 @@
+package stdlib
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (s *StdlibORM) CreateWideTables() error {
+	for _, n := range models.WideWidths {
+		if _, err := s.db.Exec(models.WideDDL(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *StdlibORM) DropWideTables() error {
+	for _, n := range models.WideWidths {
+		if _, err := s.db.Exec("DROP TABLE IF EXISTS " + models.NewWide(n).TableName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *StdlibORM) InsertWide(row models.Wide) error {
+	id, err := s.insert(models.WideInsertSQL(len(row.Columns())), row.Values()...)
+	if err != nil {
+		return err
+	}
+	row.SetID(id)
+	return nil
+}
+
+func (s *StdlibORM) GetWide(cols, limit, offset int) ([]models.Wide, error) {
+	rows, err := s.query(models.WideSelectSQL(cols), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]models.Wide, 0, limit)
+	for rows.Next() {
+		row := models.NewWide(cols)
+		if err := rows.Scan(row.Pointers()...); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
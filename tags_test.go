@@ -0,0 +1,277 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// 多对多测试的标签数和基准测试中预先写入的用户数
+const (
+	tagCount     = 20
+	taggedUsers  = 1000
+	tagPageLimit = 100
+)
+
+// tagName 第 i 个标签的名称
+func tagName(i int) string {
+	return fmt.Sprintf("tag%02d", i)
+}
+
+// userTagIndexes 第 i 个用户的标签序号：每 5 个用户中有 1 个没有标签，其余各有 3 个不同的标签
+func userTagIndexes(i int) []int {
+	if i%5 == 4 {
+		return nil
+	}
+	return []int{i % tagCount, (i + 7) % tagCount, (i + 13) % tagCount}
+}
+
+// tagFixture 多对多测试的数据：按序号排列的标签和用户
+type tagFixture struct {
+	o     orm.Interface
+	t     orm.TagInterface
+	tags  []*models.Tag
+	users []*models.User
+}
+
+// setupTags 初始化 ORM，经 ORM 写入 tagCount 个标签和 users 个用户，并按 userTagIndexes 关联
+func setupTags(tb testing.TB, ormName string, users int) (*tagFixture, func()) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		tb.Skip("null driver cannot answer join queries")
+	}
+	o, t, _, teardown := setupCapabilityWithDSN(tb, ormName, orm.TagInterface.CreateTagTables, orm.TagInterface.DropTagTables)
+
+	f := &tagFixture{o: o, t: t}
+	for i := 0; i < tagCount; i++ {
+		f.tags = append(f.tags, &models.Tag{Name: tagName(i)})
+	}
+	if err := t.InsertTags(f.tags); err != nil {
+		teardown()
+		tb.Fatalf("InsertTags failed: %v", err)
+	}
+	var err error
+	if f.users, err = f.insertUsers(users); err != nil {
+		teardown()
+		tb.Fatalf("InsertBatch failed: %v", err)
+	}
+	for i, user := range f.users {
+		if err := t.AttachTags(user.ID, f.tagIDs(userTagIndexes(i))); err != nil {
+			teardown()
+			tb.Fatalf("AttachTags failed: %v", err)
+		}
+	}
+	return f, teardown
+}
+
+// insertUsers 每批 100 个写入 n 个新用户
+func (f *tagFixture) insertUsers(n int) ([]*models.User, error) {
+	users := make([]*models.User, n)
+	for i := range users {
+		users[i] = newUser(i)
+	}
+	for start := 0; start < n; start += 100 {
+		if err := f.o.InsertBatch(users[start:min(start+100, n)]); err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
+
+// tagIDs 返回序号对应的标签 ID
+func (f *tagFixture) tagIDs(indexes []int) []int64 {
+	ids := make([]int64, len(indexes))
+	for i, index := range indexes {
+		ids[i] = f.tags[index].ID
+	}
+	return ids
+}
+
+// sortedTagNames 返回序号对应的标签名，按标签 ID（即序号）升序
+func sortedTagNames(indexes []int) []string {
+	sorted := slices.Clone(indexes)
+	slices.Sort(sorted)
+	names := make([]string, len(sorted))
+	for i, index := range sorted {
+		names[i] = tagName(index)
+	}
+	return names
+}
+
+func tagNames(tags []*models.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+// TestTags 验证各实现的多对多关联一致：分页加载的用户带有全部标签且按标签 ID 排序，
+// 没有标签的用户 Tags 为空；重复关联被忽略；按标签名查询返回恰好带有该标签的用户
+func TestTags(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			f, cleanup := setupTags(t, ormName, 30)
+			defer cleanup()
+
+			// owned 每个用户当前的标签序号
+			owned := make([][]int, len(f.users))
+			for i := range owned {
+				owned[i] = userTagIndexes(i)
+			}
+			tagIDs := make(map[string]int64, len(f.tags))
+			for _, tag := range f.tags {
+				tagIDs[tag.Name] = tag.ID
+			}
+
+			// checkPage 要求 GetUsersWithTags(limit, offset) 返回序号从 offset 开始的用户及其标签
+			checkPage := func(limit, offset int) {
+				t.Helper()
+				got, err := f.t.GetUsersWithTags(limit, offset)
+				if err != nil {
+					t.Fatalf("GetUsersWithTags(%d, %d) failed: %v", limit, offset, err)
+				}
+				if want := min(limit, len(f.users)-offset); len(got) != want {
+					t.Fatalf("GetUsersWithTags(%d, %d) returned %d users, want %d", limit, offset, len(got), want)
+				}
+				for j, user := range got {
+					i := offset + j
+					if user.ID != f.users[i].ID || user.Name != f.users[i].Name {
+						t.Errorf("user %d = %d %q, want %d %q", i, user.ID, user.Name, f.users[i].ID, f.users[i].Name)
+					}
+					if got, want := tagNames(user.Tags), sortedTagNames(owned[i]); !slices.Equal(got, want) {
+						t.Errorf("user %d has tags %v, want %v", i, got, want)
+					}
+					for _, tag := range user.Tags {
+						if tag.ID != tagIDs[tag.Name] {
+							t.Errorf("tag %q has ID %d, want %d", tag.Name, tag.ID, tagIDs[tag.Name])
+						}
+					}
+				}
+			}
+			checkPage(100, 0)
+			checkPage(10, 10)
+
+			// 重复关联被忽略，新的关联在之后的查询中可见
+			if err := f.t.AttachTags(f.users[0].ID, f.tagIDs([]int{0, 1, 7})); err != nil {
+				t.Fatalf("AttachTags with existing tags failed: %v", err)
+			}
+			owned[0] = append(owned[0], 1)
+			if err := f.t.AttachTags(f.users[0].ID, nil); err != nil {
+				t.Fatalf("AttachTags without tags failed: %v", err)
+			}
+			checkPage(5, 0)
+
+			for _, index := range []int{1, 3} {
+				users, err := f.t.GetUsersByTag(tagName(index))
+				if err != nil {
+					t.Fatalf("GetUsersByTag(%q) failed: %v", tagName(index), err)
+				}
+				var want []int64
+				for i, user := range f.users {
+					if slices.Contains(owned[i], index) {
+						want = append(want, user.ID)
+					}
+				}
+				if got := userIDs(users); !equalIDs(got, want) {
+					t.Errorf("GetUsersByTag(%q) returned IDs %v, want %v", tagName(index), got, want)
+				}
+			}
+			users, err := f.t.GetUsersByTag("missing")
+			if err != nil {
+				t.Fatalf("GetUsersByTag of missing tag failed: %v", err)
+			}
+			if len(users) != 0 {
+				t.Errorf("GetUsersByTag of missing tag returned %d users", len(users))
+			}
+		})
+	}
+}
+
+// BenchmarkTags 多对多关联：Attach 为新用户添加 3 个标签；LoadWithTags 分页加载 100 个用户及其标签；
+// ByTagName 查询带有某个标签的用户（约 1000 个用户中的 120 个）。Attach 放在最后，新增的用户不影响查询
+func BenchmarkTags_GORM(b *testing.B) {
+	benchmarkTags(b, "gorm")
+}
+
+func BenchmarkTags_XORM(b *testing.B) {
+	benchmarkTags(b, "xorm")
+}
+
+func BenchmarkTags_ZORM(b *testing.B) {
+	benchmarkTags(b, "zorm")
+}
+
+func BenchmarkTags_SQLX(b *testing.B) {
+	benchmarkTags(b, "sqlx")
+}
+
+func BenchmarkTags_BORM(b *testing.B) {
+	benchmarkTags(b, "borm")
+}
+
+func BenchmarkTags_BUN(b *testing.B) {
+	benchmarkTags(b, "bun")
+}
+
+func BenchmarkTags_ENT(b *testing.B) {
+	benchmarkTags(b, "ent")
+}
+
+func BenchmarkTags_STDLIB(b *testing.B) {
+	benchmarkTags(b, "stdlib")
+}
+
+func BenchmarkTags_DIRECT(b *testing.B) {
+	benchmarkTags(b, "direct")
+}
+
+func benchmarkTags(b *testing.B, ormName string) {
+	f, cleanup := setupTags(b, ormName, taggedUsers)
+	defer cleanup()
+
+	b.Run("LoadWithTags", func(b *testing.B) {
+		b.ResetTimer()
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+
+		for i := 0; i < b.N; i++ {
+			offset := (i * tagPageLimit) % (taggedUsers - tagPageLimit + 1)
+			if _, err := f.t.GetUsersWithTags(tagPageLimit, offset); err != nil {
+				b.Fatalf("GetUsersWithTags failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("ByTagName", func(b *testing.B) {
+		b.ResetTimer()
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+
+		for i := 0; i < b.N; i++ {
+			if _, err := f.t.GetUsersByTag(tagName(i % tagCount)); err != nil {
+				b.Fatalf("GetUsersByTag failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("Attach", func(b *testing.B) {
+		users, err := f.insertUsers(b.N)
+		if err != nil {
+			b.Fatalf("InsertBatch failed: %v", err)
+		}
+		b.ResetTimer()
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+
+		for i, user := range users {
+			// 序号 i%4 的用户都有 3 个标签
+			if err := f.t.AttachTags(user.ID, f.tagIDs(userTagIndexes(i%4))); err != nil {
+				b.Fatalf("AttachTags failed: %v", err)
+			}
+		}
+	})
+}
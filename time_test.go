@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// timeCases 不同时区、纳秒精度的时间戳
+var timeCases = []struct {
+	name string
+	ts   time.Time
+}{
+	{"utc", time.Date(2024, 3, 10, 8, 30, 15, 123456789, time.UTC)},
+	{"east", time.Date(2024, 3, 10, 16, 30, 15, 123456789, time.FixedZone("UTC+8", 8*3600))},
+	{"west", time.Date(2024, 3, 10, 3, 30, 15, 123456789, time.FixedZone("UTC-5", -5*3600))},
+}
+
+// assertTimeStored 要求时刻不变，且精度不低于微秒
+func assertTimeStored(t *testing.T, field string, got, want time.Time) {
+	t.Helper()
+	if diff := got.Sub(want); diff <= -time.Microsecond || diff >= time.Microsecond {
+		t.Errorf("%s = %s, want %s (diff %s)", field, got.Format(time.RFC3339Nano), want.Format(time.RFC3339Nano), diff)
+	}
+}
+
+// TestTimeRoundTrip 验证时间戳经过插入、更新、查询后时刻与精度不变
+func TestTimeRoundTrip(t *testing.T) {
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			o, cleanup, err := setupORM(ormName)
+			if err != nil {
+				t.Fatalf("Setup failed: %v", err)
+			}
+			defer cleanup()
+
+			for _, tc := range timeCases {
+				t.Run(tc.name, func(t *testing.T) {
+					user := &models.User{
+						Name:      "alice",
+						Email:     "alice@example.com",
+						Age:       30,
+						CreatedAt: tc.ts,
+						UpdatedAt: tc.ts.Add(time.Hour),
+					}
+					if err := o.Insert(user); err != nil {
+						t.Fatalf("Insert failed: %v", err)
+					}
+					// 转换时区不应修改调用方的模型，基准测试会复用同一批数据
+					if user.CreatedAt.Location() != tc.ts.Location() {
+						t.Errorf("Insert changed CreatedAt location to %s", user.CreatedAt.Location())
+					}
+					got, err := o.GetByID(user.ID)
+					if err != nil {
+						t.Fatalf("GetByID failed: %v", err)
+					}
+					assertTimeStored(t, "CreatedAt", got.CreatedAt, tc.ts)
+					assertTimeStored(t, "UpdatedAt", got.UpdatedAt, tc.ts.Add(time.Hour))
+					t.Logf("stored %s, read back %s (nanoseconds kept: %v)",
+						tc.ts.Format(time.RFC3339Nano), got.CreatedAt.Format(time.RFC3339Nano), got.CreatedAt.Equal(tc.ts))
+
+					updated := *got
+					updated.UpdatedAt = tc.ts.Add(24 * time.Hour)
+					if err := o.Update(&updated); err != nil {
+						t.Fatalf("Update failed: %v", err)
+					}
+					got, err = o.GetByID(user.ID)
+					if err != nil {
+						t.Fatalf("GetByID failed: %v", err)
+					}
+					assertTimeStored(t, "CreatedAt", got.CreatedAt, tc.ts)
+					assertTimeStored(t, "UpdatedAt", got.UpdatedAt, tc.ts.Add(24*time.Hour))
+				})
+			}
+		})
+	}
+}
+
+// TestCreatedRange 验证混合时区写入后按时刻做范围查询
+func TestCreatedRange(t *testing.T) {
+	zones := []*time.Location{time.UTC, time.FixedZone("UTC+8", 8*3600), time.FixedZone("UTC-5", -5*3600)}
+	base := time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)
+
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			o, cleanup, err := setupORM(ormName)
+			if err != nil {
+				t.Fatalf("Setup failed: %v", err)
+			}
+			defer cleanup()
+
+			// 倒序插入，时间间隔 500ms，时区轮换
+			ids := make([]int64, 10)
+			for i := len(ids) - 1; i >= 0; i-- {
+				ts := base.Add(time.Duration(i) * 500 * time.Millisecond).In(zones[i%len(zones)])
+				user := &models.User{
+					Name:      fmt.Sprintf("user%d", i),
+					Email:     fmt.Sprintf("user%d@example.com", i),
+					Age:       20 + i,
+					CreatedAt: ts,
+					UpdatedAt: ts,
+				}
+				if err := o.Insert(user); err != nil {
+					t.Fatalf("Insert failed: %v", err)
+				}
+				ids[i] = user.ID
+			}
+
+			from := base.Add(1500 * time.Millisecond).In(zones[1])
+			to := base.Add(3500 * time.Millisecond).In(zones[2])
+			users, err := o.GetByCreatedRange(from, to)
+			if err != nil {
+				t.Fatalf("GetByCreatedRange failed: %v", err)
+			}
+			var got []int64
+			for _, u := range users {
+				got = append(got, u.ID)
+			}
+			want := ids[3:7]
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("GetByCreatedRange returned IDs %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// BenchmarkTimeRange created_at 索引范围查询测试
+func BenchmarkTimeRange_GORM(b *testing.B) {
+	benchmarkTimeRange(b, "gorm")
+}
+
+func BenchmarkTimeRange_XORM(b *testing.B) {
+	benchmarkTimeRange(b, "xorm")
+}
+
+func BenchmarkTimeRange_ZORM(b *testing.B) {
+	benchmarkTimeRange(b, "zorm")
+}
+
+func BenchmarkTimeRange_SQLX(b *testing.B) {
+	benchmarkTimeRange(b, "sqlx")
+}
+
+func BenchmarkTimeRange_BORM(b *testing.B) {
+	benchmarkTimeRange(b, "borm")
+}
+
+func BenchmarkTimeRange_BUN(b *testing.B) {
+	benchmarkTimeRange(b, "bun")
+}
+
+func BenchmarkTimeRange_ENT(b *testing.B) {
+	benchmarkTimeRange(b, "ent")
+}
+
+func BenchmarkTimeRange_STDLIB(b *testing.B) {
+	benchmarkTimeRange(b, "stdlib")
+}
+
+func BenchmarkTimeRange_DIRECT(b *testing.B) {
+	benchmarkTimeRange(b, "direct")
+}
+
+func benchmarkTimeRange(b *testing.B, ormName string) {
+	skipIfCanned(b)
+	orm, cleanup, err := setupORM(ormName)
+	if err != nil {
+		b.Fatalf("Setup failed: %v", err)
+	}
+	defer cleanup()
+
+	// 预先插入一些数据，created_at 每条间隔 1 秒
+	for i := 0; i < 1000; i++ {
+		ts := benchTime.Add(time.Duration(i) * time.Second)
+		user := &models.User{
+			Name:      fmt.Sprintf("user%d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + (i % 50),
+			CreatedAt: ts,
+			UpdatedAt: ts,
+		}
+		if err := orm.Insert(user); err != nil {
+			b.Fatalf("Pre-insert failed: %v", err)
+		}
+	}
+
+	window := 100
+	b.ResetTimer()
+	b.ReportAllocs()
+	defer trackBenchmark(b).report()
+
+	for i := 0; i < b.N; i++ {
+		from := benchTime.Add(time.Duration((i*window)%900) * time.Second)
+		users, err := orm.GetByCreatedRange(from, from.Add(time.Duration(window)*time.Second))
+		if err != nil {
+			b.Fatalf("GetByCreatedRange failed: %v", err)
+		}
+		if len(users) != window {
+			b.Fatalf("GetByCreatedRange returned %d rows, want %d", len(users), window)
+		}
+	}
+}
@@ -0,0 +1,830 @@
+// Code generated by genvariants.go; DO NOT EDIT.
+
+package main
+
+import "testing"
+
+// BenchmarkColdStart 配置变体
+func BenchmarkColdStart_BUN_PREPARED(b *testing.B) {
+	benchmarkColdStart(b, "bun+prepared")
+}
+
+func BenchmarkColdStart_ENT_PREPARED(b *testing.B) {
+	benchmarkColdStart(b, "ent+prepared")
+}
+
+func BenchmarkColdStart_GORM_NOTX(b *testing.B) {
+	benchmarkColdStart(b, "gorm+notx")
+}
+
+func BenchmarkColdStart_GORM_PREPARED(b *testing.B) {
+	benchmarkColdStart(b, "gorm+prepared")
+}
+
+func BenchmarkColdStart_GORM_TUNED(b *testing.B) {
+	benchmarkColdStart(b, "gorm+tuned")
+}
+
+func BenchmarkColdStart_SQLX_PREPARED(b *testing.B) {
+	benchmarkColdStart(b, "sqlx+prepared")
+}
+
+func BenchmarkColdStart_STDLIB_PREPARED(b *testing.B) {
+	benchmarkColdStart(b, "stdlib+prepared")
+}
+
+func BenchmarkColdStart_XORM_CACHE(b *testing.B) {
+	benchmarkColdStart(b, "xorm+cache")
+}
+
+// BenchmarkInsertSingle 配置变体
+func BenchmarkInsertSingle_BUN_PREPARED(b *testing.B) {
+	benchmarkInsertSingle(b, "bun+prepared")
+}
+
+func BenchmarkInsertSingle_ENT_PREPARED(b *testing.B) {
+	benchmarkInsertSingle(b, "ent+prepared")
+}
+
+func BenchmarkInsertSingle_GORM_NOTX(b *testing.B) {
+	benchmarkInsertSingle(b, "gorm+notx")
+}
+
+func BenchmarkInsertSingle_GORM_PREPARED(b *testing.B) {
+	benchmarkInsertSingle(b, "gorm+prepared")
+}
+
+func BenchmarkInsertSingle_GORM_TUNED(b *testing.B) {
+	benchmarkInsertSingle(b, "gorm+tuned")
+}
+
+func BenchmarkInsertSingle_SQLX_PREPARED(b *testing.B) {
+	benchmarkInsertSingle(b, "sqlx+prepared")
+}
+
+func BenchmarkInsertSingle_STDLIB_PREPARED(b *testing.B) {
+	benchmarkInsertSingle(b, "stdlib+prepared")
+}
+
+func BenchmarkInsertSingle_XORM_CACHE(b *testing.B) {
+	benchmarkInsertSingle(b, "xorm+cache")
+}
+
+// BenchmarkInsertBatch 配置变体
+func BenchmarkInsertBatch_BUN_PREPARED(b *testing.B) {
+	benchmarkInsertBatch(b, "bun+prepared")
+}
+
+func BenchmarkInsertBatch_ENT_PREPARED(b *testing.B) {
+	benchmarkInsertBatch(b, "ent+prepared")
+}
+
+func BenchmarkInsertBatch_GORM_NOTX(b *testing.B) {
+	benchmarkInsertBatch(b, "gorm+notx")
+}
+
+func BenchmarkInsertBatch_GORM_PREPARED(b *testing.B) {
+	benchmarkInsertBatch(b, "gorm+prepared")
+}
+
+func BenchmarkInsertBatch_GORM_TUNED(b *testing.B) {
+	benchmarkInsertBatch(b, "gorm+tuned")
+}
+
+func BenchmarkInsertBatch_SQLX_PREPARED(b *testing.B) {
+	benchmarkInsertBatch(b, "sqlx+prepared")
+}
+
+func BenchmarkInsertBatch_STDLIB_PREPARED(b *testing.B) {
+	benchmarkInsertBatch(b, "stdlib+prepared")
+}
+
+func BenchmarkInsertBatch_XORM_CACHE(b *testing.B) {
+	benchmarkInsertBatch(b, "xorm+cache")
+}
+
+// BenchmarkGetByID 配置变体
+func BenchmarkGetByID_BUN_PREPARED(b *testing.B) {
+	benchmarkGetByID(b, "bun+prepared")
+}
+
+func BenchmarkGetByID_ENT_PREPARED(b *testing.B) {
+	benchmarkGetByID(b, "ent+prepared")
+}
+
+func BenchmarkGetByID_GORM_NOTX(b *testing.B) {
+	benchmarkGetByID(b, "gorm+notx")
+}
+
+func BenchmarkGetByID_GORM_PREPARED(b *testing.B) {
+	benchmarkGetByID(b, "gorm+prepared")
+}
+
+func BenchmarkGetByID_GORM_TUNED(b *testing.B) {
+	benchmarkGetByID(b, "gorm+tuned")
+}
+
+func BenchmarkGetByID_SQLX_PREPARED(b *testing.B) {
+	benchmarkGetByID(b, "sqlx+prepared")
+}
+
+func BenchmarkGetByID_STDLIB_PREPARED(b *testing.B) {
+	benchmarkGetByID(b, "stdlib+prepared")
+}
+
+func BenchmarkGetByID_XORM_CACHE(b *testing.B) {
+	benchmarkGetByID(b, "xorm+cache")
+}
+
+// BenchmarkGetByIDs 配置变体
+func BenchmarkGetByIDs_BUN_PREPARED(b *testing.B) {
+	benchmarkGetByIDs(b, "bun+prepared")
+}
+
+func BenchmarkGetByIDs_ENT_PREPARED(b *testing.B) {
+	benchmarkGetByIDs(b, "ent+prepared")
+}
+
+func BenchmarkGetByIDs_GORM_NOTX(b *testing.B) {
+	benchmarkGetByIDs(b, "gorm+notx")
+}
+
+func BenchmarkGetByIDs_GORM_PREPARED(b *testing.B) {
+	benchmarkGetByIDs(b, "gorm+prepared")
+}
+
+func BenchmarkGetByIDs_GORM_TUNED(b *testing.B) {
+	benchmarkGetByIDs(b, "gorm+tuned")
+}
+
+func BenchmarkGetByIDs_SQLX_PREPARED(b *testing.B) {
+	benchmarkGetByIDs(b, "sqlx+prepared")
+}
+
+func BenchmarkGetByIDs_STDLIB_PREPARED(b *testing.B) {
+	benchmarkGetByIDs(b, "stdlib+prepared")
+}
+
+func BenchmarkGetByIDs_XORM_CACHE(b *testing.B) {
+	benchmarkGetByIDs(b, "xorm+cache")
+}
+
+// BenchmarkUpdate 配置变体
+func BenchmarkUpdate_BUN_PREPARED(b *testing.B) {
+	benchmarkUpdate(b, "bun+prepared")
+}
+
+func BenchmarkUpdate_ENT_PREPARED(b *testing.B) {
+	benchmarkUpdate(b, "ent+prepared")
+}
+
+func BenchmarkUpdate_GORM_NOTX(b *testing.B) {
+	benchmarkUpdate(b, "gorm+notx")
+}
+
+func BenchmarkUpdate_GORM_PREPARED(b *testing.B) {
+	benchmarkUpdate(b, "gorm+prepared")
+}
+
+func BenchmarkUpdate_GORM_TUNED(b *testing.B) {
+	benchmarkUpdate(b, "gorm+tuned")
+}
+
+func BenchmarkUpdate_SQLX_PREPARED(b *testing.B) {
+	benchmarkUpdate(b, "sqlx+prepared")
+}
+
+func BenchmarkUpdate_STDLIB_PREPARED(b *testing.B) {
+	benchmarkUpdate(b, "stdlib+prepared")
+}
+
+func BenchmarkUpdate_XORM_CACHE(b *testing.B) {
+	benchmarkUpdate(b, "xorm+cache")
+}
+
+// BenchmarkDelete 配置变体
+func BenchmarkDelete_BUN_PREPARED(b *testing.B) {
+	benchmarkDelete(b, "bun+prepared")
+}
+
+func BenchmarkDelete_ENT_PREPARED(b *testing.B) {
+	benchmarkDelete(b, "ent+prepared")
+}
+
+func BenchmarkDelete_GORM_NOTX(b *testing.B) {
+	benchmarkDelete(b, "gorm+notx")
+}
+
+func BenchmarkDelete_GORM_PREPARED(b *testing.B) {
+	benchmarkDelete(b, "gorm+prepared")
+}
+
+func BenchmarkDelete_GORM_TUNED(b *testing.B) {
+	benchmarkDelete(b, "gorm+tuned")
+}
+
+func BenchmarkDelete_SQLX_PREPARED(b *testing.B) {
+	benchmarkDelete(b, "sqlx+prepared")
+}
+
+func BenchmarkDelete_STDLIB_PREPARED(b *testing.B) {
+	benchmarkDelete(b, "stdlib+prepared")
+}
+
+func BenchmarkDelete_XORM_CACHE(b *testing.B) {
+	benchmarkDelete(b, "xorm+cache")
+}
+
+// BenchmarkCount 配置变体
+func BenchmarkCount_BUN_PREPARED(b *testing.B) {
+	benchmarkCount(b, "bun+prepared")
+}
+
+func BenchmarkCount_ENT_PREPARED(b *testing.B) {
+	benchmarkCount(b, "ent+prepared")
+}
+
+func BenchmarkCount_GORM_NOTX(b *testing.B) {
+	benchmarkCount(b, "gorm+notx")
+}
+
+func BenchmarkCount_GORM_PREPARED(b *testing.B) {
+	benchmarkCount(b, "gorm+prepared")
+}
+
+func BenchmarkCount_GORM_TUNED(b *testing.B) {
+	benchmarkCount(b, "gorm+tuned")
+}
+
+func BenchmarkCount_SQLX_PREPARED(b *testing.B) {
+	benchmarkCount(b, "sqlx+prepared")
+}
+
+func BenchmarkCount_STDLIB_PREPARED(b *testing.B) {
+	benchmarkCount(b, "stdlib+prepared")
+}
+
+func BenchmarkCount_XORM_CACHE(b *testing.B) {
+	benchmarkCount(b, "xorm+cache")
+}
+
+// BenchmarkGetAll 配置变体
+func BenchmarkGetAll_BUN_PREPARED(b *testing.B) {
+	benchmarkGetAll(b, "bun+prepared")
+}
+
+func BenchmarkGetAll_ENT_PREPARED(b *testing.B) {
+	benchmarkGetAll(b, "ent+prepared")
+}
+
+func BenchmarkGetAll_GORM_NOTX(b *testing.B) {
+	benchmarkGetAll(b, "gorm+notx")
+}
+
+func BenchmarkGetAll_GORM_PREPARED(b *testing.B) {
+	benchmarkGetAll(b, "gorm+prepared")
+}
+
+func BenchmarkGetAll_GORM_TUNED(b *testing.B) {
+	benchmarkGetAll(b, "gorm+tuned")
+}
+
+func BenchmarkGetAll_SQLX_PREPARED(b *testing.B) {
+	benchmarkGetAll(b, "sqlx+prepared")
+}
+
+func BenchmarkGetAll_STDLIB_PREPARED(b *testing.B) {
+	benchmarkGetAll(b, "stdlib+prepared")
+}
+
+func BenchmarkGetAll_XORM_CACHE(b *testing.B) {
+	benchmarkGetAll(b, "xorm+cache")
+}
+
+// BenchmarkRawQuery 配置变体
+func BenchmarkRawQuery_BUN_PREPARED(b *testing.B) {
+	benchmarkRawQuery(b, "bun+prepared")
+}
+
+func BenchmarkRawQuery_ENT_PREPARED(b *testing.B) {
+	benchmarkRawQuery(b, "ent+prepared")
+}
+
+func BenchmarkRawQuery_GORM_NOTX(b *testing.B) {
+	benchmarkRawQuery(b, "gorm+notx")
+}
+
+func BenchmarkRawQuery_GORM_PREPARED(b *testing.B) {
+	benchmarkRawQuery(b, "gorm+prepared")
+}
+
+func BenchmarkRawQuery_GORM_TUNED(b *testing.B) {
+	benchmarkRawQuery(b, "gorm+tuned")
+}
+
+func BenchmarkRawQuery_SQLX_PREPARED(b *testing.B) {
+	benchmarkRawQuery(b, "sqlx+prepared")
+}
+
+func BenchmarkRawQuery_STDLIB_PREPARED(b *testing.B) {
+	benchmarkRawQuery(b, "stdlib+prepared")
+}
+
+func BenchmarkRawQuery_XORM_CACHE(b *testing.B) {
+	benchmarkRawQuery(b, "xorm+cache")
+}
+
+// BenchmarkHooks 配置变体
+func BenchmarkHooks_BUN_PREPARED(b *testing.B) {
+	benchmarkHooks(b, "bun+prepared")
+}
+
+func BenchmarkHooks_ENT_PREPARED(b *testing.B) {
+	benchmarkHooks(b, "ent+prepared")
+}
+
+func BenchmarkHooks_GORM_NOTX(b *testing.B) {
+	benchmarkHooks(b, "gorm+notx")
+}
+
+func BenchmarkHooks_GORM_PREPARED(b *testing.B) {
+	benchmarkHooks(b, "gorm+prepared")
+}
+
+func BenchmarkHooks_GORM_TUNED(b *testing.B) {
+	benchmarkHooks(b, "gorm+tuned")
+}
+
+func BenchmarkHooks_SQLX_PREPARED(b *testing.B) {
+	benchmarkHooks(b, "sqlx+prepared")
+}
+
+func BenchmarkHooks_STDLIB_PREPARED(b *testing.B) {
+	benchmarkHooks(b, "stdlib+prepared")
+}
+
+func BenchmarkHooks_XORM_CACHE(b *testing.B) {
+	benchmarkHooks(b, "xorm+cache")
+}
+
+// BenchmarkFullScan 配置变体
+func BenchmarkFullScan_BUN_PREPARED(b *testing.B) {
+	benchmarkFullScan(b, "bun+prepared")
+}
+
+func BenchmarkFullScan_ENT_PREPARED(b *testing.B) {
+	benchmarkFullScan(b, "ent+prepared")
+}
+
+func BenchmarkFullScan_GORM_NOTX(b *testing.B) {
+	benchmarkFullScan(b, "gorm+notx")
+}
+
+func BenchmarkFullScan_GORM_PREPARED(b *testing.B) {
+	benchmarkFullScan(b, "gorm+prepared")
+}
+
+func BenchmarkFullScan_GORM_TUNED(b *testing.B) {
+	benchmarkFullScan(b, "gorm+tuned")
+}
+
+func BenchmarkFullScan_SQLX_PREPARED(b *testing.B) {
+	benchmarkFullScan(b, "sqlx+prepared")
+}
+
+func BenchmarkFullScan_STDLIB_PREPARED(b *testing.B) {
+	benchmarkFullScan(b, "stdlib+prepared")
+}
+
+func BenchmarkFullScan_XORM_CACHE(b *testing.B) {
+	benchmarkFullScan(b, "xorm+cache")
+}
+
+// BenchmarkJSONInsert 配置变体
+func BenchmarkJSONInsert_BUN_PREPARED(b *testing.B) {
+	benchmarkJSONInsert(b, "bun+prepared")
+}
+
+func BenchmarkJSONInsert_ENT_PREPARED(b *testing.B) {
+	benchmarkJSONInsert(b, "ent+prepared")
+}
+
+func BenchmarkJSONInsert_GORM_NOTX(b *testing.B) {
+	benchmarkJSONInsert(b, "gorm+notx")
+}
+
+func BenchmarkJSONInsert_GORM_PREPARED(b *testing.B) {
+	benchmarkJSONInsert(b, "gorm+prepared")
+}
+
+func BenchmarkJSONInsert_GORM_TUNED(b *testing.B) {
+	benchmarkJSONInsert(b, "gorm+tuned")
+}
+
+func BenchmarkJSONInsert_SQLX_PREPARED(b *testing.B) {
+	benchmarkJSONInsert(b, "sqlx+prepared")
+}
+
+func BenchmarkJSONInsert_STDLIB_PREPARED(b *testing.B) {
+	benchmarkJSONInsert(b, "stdlib+prepared")
+}
+
+func BenchmarkJSONInsert_XORM_CACHE(b *testing.B) {
+	benchmarkJSONInsert(b, "xorm+cache")
+}
+
+// BenchmarkJSONSelect 配置变体
+func BenchmarkJSONSelect_BUN_PREPARED(b *testing.B) {
+	benchmarkJSONSelect(b, "bun+prepared")
+}
+
+func BenchmarkJSONSelect_ENT_PREPARED(b *testing.B) {
+	benchmarkJSONSelect(b, "ent+prepared")
+}
+
+func BenchmarkJSONSelect_GORM_NOTX(b *testing.B) {
+	benchmarkJSONSelect(b, "gorm+notx")
+}
+
+func BenchmarkJSONSelect_GORM_PREPARED(b *testing.B) {
+	benchmarkJSONSelect(b, "gorm+prepared")
+}
+
+func BenchmarkJSONSelect_GORM_TUNED(b *testing.B) {
+	benchmarkJSONSelect(b, "gorm+tuned")
+}
+
+func BenchmarkJSONSelect_SQLX_PREPARED(b *testing.B) {
+	benchmarkJSONSelect(b, "sqlx+prepared")
+}
+
+func BenchmarkJSONSelect_STDLIB_PREPARED(b *testing.B) {
+	benchmarkJSONSelect(b, "stdlib+prepared")
+}
+
+func BenchmarkJSONSelect_XORM_CACHE(b *testing.B) {
+	benchmarkJSONSelect(b, "xorm+cache")
+}
+
+// BenchmarkKeys 配置变体
+func BenchmarkKeys_BUN_PREPARED(b *testing.B) {
+	benchmarkKeys(b, "bun+prepared")
+}
+
+func BenchmarkKeys_ENT_PREPARED(b *testing.B) {
+	benchmarkKeys(b, "ent+prepared")
+}
+
+func BenchmarkKeys_GORM_NOTX(b *testing.B) {
+	benchmarkKeys(b, "gorm+notx")
+}
+
+func BenchmarkKeys_GORM_PREPARED(b *testing.B) {
+	benchmarkKeys(b, "gorm+prepared")
+}
+
+func BenchmarkKeys_GORM_TUNED(b *testing.B) {
+	benchmarkKeys(b, "gorm+tuned")
+}
+
+func BenchmarkKeys_SQLX_PREPARED(b *testing.B) {
+	benchmarkKeys(b, "sqlx+prepared")
+}
+
+func BenchmarkKeys_STDLIB_PREPARED(b *testing.B) {
+	benchmarkKeys(b, "stdlib+prepared")
+}
+
+func BenchmarkKeys_XORM_CACHE(b *testing.B) {
+	benchmarkKeys(b, "xorm+cache")
+}
+
+// BenchmarkMigrate 配置变体
+func BenchmarkMigrate_BUN_PREPARED(b *testing.B) {
+	benchmarkMigrate(b, "bun+prepared")
+}
+
+func BenchmarkMigrate_ENT_PREPARED(b *testing.B) {
+	benchmarkMigrate(b, "ent+prepared")
+}
+
+func BenchmarkMigrate_GORM_NOTX(b *testing.B) {
+	benchmarkMigrate(b, "gorm+notx")
+}
+
+func BenchmarkMigrate_GORM_PREPARED(b *testing.B) {
+	benchmarkMigrate(b, "gorm+prepared")
+}
+
+func BenchmarkMigrate_GORM_TUNED(b *testing.B) {
+	benchmarkMigrate(b, "gorm+tuned")
+}
+
+func BenchmarkMigrate_SQLX_PREPARED(b *testing.B) {
+	benchmarkMigrate(b, "sqlx+prepared")
+}
+
+func BenchmarkMigrate_STDLIB_PREPARED(b *testing.B) {
+	benchmarkMigrate(b, "stdlib+prepared")
+}
+
+func BenchmarkMigrate_XORM_CACHE(b *testing.B) {
+	benchmarkMigrate(b, "xorm+cache")
+}
+
+// BenchmarkNullableSelect 配置变体
+func BenchmarkNullableSelect_BUN_PREPARED(b *testing.B) {
+	benchmarkNullableSelect(b, "bun+prepared")
+}
+
+func BenchmarkNullableSelect_ENT_PREPARED(b *testing.B) {
+	benchmarkNullableSelect(b, "ent+prepared")
+}
+
+func BenchmarkNullableSelect_GORM_NOTX(b *testing.B) {
+	benchmarkNullableSelect(b, "gorm+notx")
+}
+
+func BenchmarkNullableSelect_GORM_PREPARED(b *testing.B) {
+	benchmarkNullableSelect(b, "gorm+prepared")
+}
+
+func BenchmarkNullableSelect_GORM_TUNED(b *testing.B) {
+	benchmarkNullableSelect(b, "gorm+tuned")
+}
+
+func BenchmarkNullableSelect_SQLX_PREPARED(b *testing.B) {
+	benchmarkNullableSelect(b, "sqlx+prepared")
+}
+
+func BenchmarkNullableSelect_STDLIB_PREPARED(b *testing.B) {
+	benchmarkNullableSelect(b, "stdlib+prepared")
+}
+
+func BenchmarkNullableSelect_XORM_CACHE(b *testing.B) {
+	benchmarkNullableSelect(b, "xorm+cache")
+}
+
+// BenchmarkPostInsert 配置变体
+func BenchmarkPostInsert_BUN_PREPARED(b *testing.B) {
+	benchmarkPostInsert(b, "bun+prepared")
+}
+
+func BenchmarkPostInsert_ENT_PREPARED(b *testing.B) {
+	benchmarkPostInsert(b, "ent+prepared")
+}
+
+func BenchmarkPostInsert_GORM_NOTX(b *testing.B) {
+	benchmarkPostInsert(b, "gorm+notx")
+}
+
+func BenchmarkPostInsert_GORM_PREPARED(b *testing.B) {
+	benchmarkPostInsert(b, "gorm+prepared")
+}
+
+func BenchmarkPostInsert_GORM_TUNED(b *testing.B) {
+	benchmarkPostInsert(b, "gorm+tuned")
+}
+
+func BenchmarkPostInsert_SQLX_PREPARED(b *testing.B) {
+	benchmarkPostInsert(b, "sqlx+prepared")
+}
+
+func BenchmarkPostInsert_STDLIB_PREPARED(b *testing.B) {
+	benchmarkPostInsert(b, "stdlib+prepared")
+}
+
+func BenchmarkPostInsert_XORM_CACHE(b *testing.B) {
+	benchmarkPostInsert(b, "xorm+cache")
+}
+
+// BenchmarkPostFetch 配置变体
+func BenchmarkPostFetch_BUN_PREPARED(b *testing.B) {
+	benchmarkPostFetch(b, "bun+prepared")
+}
+
+func BenchmarkPostFetch_ENT_PREPARED(b *testing.B) {
+	benchmarkPostFetch(b, "ent+prepared")
+}
+
+func BenchmarkPostFetch_GORM_NOTX(b *testing.B) {
+	benchmarkPostFetch(b, "gorm+notx")
+}
+
+func BenchmarkPostFetch_GORM_PREPARED(b *testing.B) {
+	benchmarkPostFetch(b, "gorm+prepared")
+}
+
+func BenchmarkPostFetch_GORM_TUNED(b *testing.B) {
+	benchmarkPostFetch(b, "gorm+tuned")
+}
+
+func BenchmarkPostFetch_SQLX_PREPARED(b *testing.B) {
+	benchmarkPostFetch(b, "sqlx+prepared")
+}
+
+func BenchmarkPostFetch_STDLIB_PREPARED(b *testing.B) {
+	benchmarkPostFetch(b, "stdlib+prepared")
+}
+
+func BenchmarkPostFetch_XORM_CACHE(b *testing.B) {
+	benchmarkPostFetch(b, "xorm+cache")
+}
+
+// BenchmarkSoftDelete 配置变体
+func BenchmarkSoftDelete_BUN_PREPARED(b *testing.B) {
+	benchmarkSoftDelete(b, "bun+prepared")
+}
+
+func BenchmarkSoftDelete_ENT_PREPARED(b *testing.B) {
+	benchmarkSoftDelete(b, "ent+prepared")
+}
+
+func BenchmarkSoftDelete_GORM_NOTX(b *testing.B) {
+	benchmarkSoftDelete(b, "gorm+notx")
+}
+
+func BenchmarkSoftDelete_GORM_PREPARED(b *testing.B) {
+	benchmarkSoftDelete(b, "gorm+prepared")
+}
+
+func BenchmarkSoftDelete_GORM_TUNED(b *testing.B) {
+	benchmarkSoftDelete(b, "gorm+tuned")
+}
+
+func BenchmarkSoftDelete_SQLX_PREPARED(b *testing.B) {
+	benchmarkSoftDelete(b, "sqlx+prepared")
+}
+
+func BenchmarkSoftDelete_STDLIB_PREPARED(b *testing.B) {
+	benchmarkSoftDelete(b, "stdlib+prepared")
+}
+
+func BenchmarkSoftDelete_XORM_CACHE(b *testing.B) {
+	benchmarkSoftDelete(b, "xorm+cache")
+}
+
+// BenchmarkTags 配置变体
+func BenchmarkTags_BUN_PREPARED(b *testing.B) {
+	benchmarkTags(b, "bun+prepared")
+}
+
+func BenchmarkTags_ENT_PREPARED(b *testing.B) {
+	benchmarkTags(b, "ent+prepared")
+}
+
+func BenchmarkTags_GORM_NOTX(b *testing.B) {
+	benchmarkTags(b, "gorm+notx")
+}
+
+func BenchmarkTags_GORM_PREPARED(b *testing.B) {
+	benchmarkTags(b, "gorm+prepared")
+}
+
+func BenchmarkTags_GORM_TUNED(b *testing.B) {
+	benchmarkTags(b, "gorm+tuned")
+}
+
+func BenchmarkTags_SQLX_PREPARED(b *testing.B) {
+	benchmarkTags(b, "sqlx+prepared")
+}
+
+func BenchmarkTags_STDLIB_PREPARED(b *testing.B) {
+	benchmarkTags(b, "stdlib+prepared")
+}
+
+func BenchmarkTags_XORM_CACHE(b *testing.B) {
+	benchmarkTags(b, "xorm+cache")
+}
+
+// BenchmarkTimeRange 配置变体
+func BenchmarkTimeRange_BUN_PREPARED(b *testing.B) {
+	benchmarkTimeRange(b, "bun+prepared")
+}
+
+func BenchmarkTimeRange_ENT_PREPARED(b *testing.B) {
+	benchmarkTimeRange(b, "ent+prepared")
+}
+
+func BenchmarkTimeRange_GORM_NOTX(b *testing.B) {
+	benchmarkTimeRange(b, "gorm+notx")
+}
+
+func BenchmarkTimeRange_GORM_PREPARED(b *testing.B) {
+	benchmarkTimeRange(b, "gorm+prepared")
+}
+
+func BenchmarkTimeRange_GORM_TUNED(b *testing.B) {
+	benchmarkTimeRange(b, "gorm+tuned")
+}
+
+func BenchmarkTimeRange_SQLX_PREPARED(b *testing.B) {
+	benchmarkTimeRange(b, "sqlx+prepared")
+}
+
+func BenchmarkTimeRange_STDLIB_PREPARED(b *testing.B) {
+	benchmarkTimeRange(b, "stdlib+prepared")
+}
+
+func BenchmarkTimeRange_XORM_CACHE(b *testing.B) {
+	benchmarkTimeRange(b, "xorm+cache")
+}
+
+// BenchmarkOptimisticLock 配置变体
+func BenchmarkOptimisticLock_BUN_PREPARED(b *testing.B) {
+	benchmarkOptimisticLock(b, "bun+prepared")
+}
+
+func BenchmarkOptimisticLock_ENT_PREPARED(b *testing.B) {
+	benchmarkOptimisticLock(b, "ent+prepared")
+}
+
+func BenchmarkOptimisticLock_GORM_NOTX(b *testing.B) {
+	benchmarkOptimisticLock(b, "gorm+notx")
+}
+
+func BenchmarkOptimisticLock_GORM_PREPARED(b *testing.B) {
+	benchmarkOptimisticLock(b, "gorm+prepared")
+}
+
+func BenchmarkOptimisticLock_GORM_TUNED(b *testing.B) {
+	benchmarkOptimisticLock(b, "gorm+tuned")
+}
+
+func BenchmarkOptimisticLock_SQLX_PREPARED(b *testing.B) {
+	benchmarkOptimisticLock(b, "sqlx+prepared")
+}
+
+func BenchmarkOptimisticLock_STDLIB_PREPARED(b *testing.B) {
+	benchmarkOptimisticLock(b, "stdlib+prepared")
+}
+
+func BenchmarkOptimisticLock_XORM_CACHE(b *testing.B) {
+	benchmarkOptimisticLock(b, "xorm+cache")
+}
+
+// BenchmarkWideInsert 配置变体
+func BenchmarkWideInsert_BUN_PREPARED(b *testing.B) {
+	benchmarkWideInsert(b, "bun+prepared")
+}
+
+func BenchmarkWideInsert_ENT_PREPARED(b *testing.B) {
+	benchmarkWideInsert(b, "ent+prepared")
+}
+
+func BenchmarkWideInsert_GORM_NOTX(b *testing.B) {
+	benchmarkWideInsert(b, "gorm+notx")
+}
+
+func BenchmarkWideInsert_GORM_PREPARED(b *testing.B) {
+	benchmarkWideInsert(b, "gorm+prepared")
+}
+
+func BenchmarkWideInsert_GORM_TUNED(b *testing.B) {
+	benchmarkWideInsert(b, "gorm+tuned")
+}
+
+func BenchmarkWideInsert_SQLX_PREPARED(b *testing.B) {
+	benchmarkWideInsert(b, "sqlx+prepared")
+}
+
+func BenchmarkWideInsert_STDLIB_PREPARED(b *testing.B) {
+	benchmarkWideInsert(b, "stdlib+prepared")
+}
+
+func BenchmarkWideInsert_XORM_CACHE(b *testing.B) {
+	benchmarkWideInsert(b, "xorm+cache")
+}
+
+// BenchmarkWideSelect 配置变体
+func BenchmarkWideSelect_BUN_PREPARED(b *testing.B) {
+	benchmarkWideSelect(b, "bun+prepared")
+}
+
+func BenchmarkWideSelect_ENT_PREPARED(b *testing.B) {
+	benchmarkWideSelect(b, "ent+prepared")
+}
+
+func BenchmarkWideSelect_GORM_NOTX(b *testing.B) {
+	benchmarkWideSelect(b, "gorm+notx")
+}
+
+func BenchmarkWideSelect_GORM_PREPARED(b *testing.B) {
+	benchmarkWideSelect(b, "gorm+prepared")
+}
+
+func BenchmarkWideSelect_GORM_TUNED(b *testing.B) {
+	benchmarkWideSelect(b, "gorm+tuned")
+}
+
+func BenchmarkWideSelect_SQLX_PREPARED(b *testing.B) {
+	benchmarkWideSelect(b, "sqlx+prepared")
+}
+
+func BenchmarkWideSelect_STDLIB_PREPARED(b *testing.B) {
+	benchmarkWideSelect(b, "stdlib+prepared")
+}
+
+func BenchmarkWideSelect_XORM_CACHE(b *testing.B) {
+	benchmarkWideSelect(b, "xorm+cache")
+}
@@ -0,0 +1,24 @@
+//go:generate go run genvariants.go
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+// TestVariantsRegistered 检查注册表中的配置变体与 orm.Variants 的说明一一对应
+func TestVariantsRegistered(t *testing.T) {
+	for name := range orm.Variants {
+		if _, ok := orms[name]; !ok {
+			t.Errorf("variant %s is described in orm.Variants but not registered in orms", name)
+		}
+	}
+	for name := range orms {
+		if _, ok := orm.Variants[name]; strings.Contains(name, "+") && !ok {
+			t.Errorf("variant %s is registered in orms but has no description in orm.Variants", name)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+	"github.com/benchplus/goorm/internal/sqldriver"
+)
+
+// 乐观锁并发测试的写入者数和每个写入者成功更新的次数
+const (
+	versionWriters = 8
+	versionUpdates = 10
+)
+
+// setupVersioned 初始化 ORM 并创建 versioned_users 表
+func setupVersioned(tb testing.TB, ormName string) (orm.VersionInterface, func()) {
+	return setupCapability(tb, ormName, orm.VersionInterface.CreateVersionedTable, orm.VersionInterface.DropVersionedTable)
+}
+
+func newVersionedUser(i int) *models.VersionedUser {
+	return &models.VersionedUser{
+		Name:  fmt.Sprintf("user%d", i),
+		Email: fmt.Sprintf("user%d@example.com", i),
+		Age:   20 + i%50,
+	}
+}
+
+// updateWithRetry 读取最新版本后条件更新，冲突时重试，返回重试的次数
+func updateWithRetry(v orm.VersionInterface, id int64, age int) (int, error) {
+	for retries := 0; ; retries++ {
+		user, err := v.GetVersionedByID(id)
+		if err != nil {
+			return retries, err
+		}
+		user.Age = age
+		err = v.UpdateIfVersion(user, user.Version)
+		if err == nil {
+			return retries, nil
+		}
+		if !errors.Is(err, orm.ErrVersionConflict) {
+			return retries, err
+		}
+	}
+}
+
+// TestOptimisticLock 验证各实现的乐观锁语义一致：版本号从 1 开始，每次成功更新加一；
+// 版本号过期或记录不存在时返回 orm.ErrVersionConflict 且不修改记录；并发写入者不丢失更新
+func TestOptimisticLock(t *testing.T) {
+	if sqldriver.Name() == sqldriver.NullDriverName {
+		t.Skip("null driver returns canned results")
+	}
+	for _, ormName := range ormNames() {
+		t.Run(ormName, func(t *testing.T) {
+			v, cleanup := setupVersioned(t, ormName)
+			defer cleanup()
+
+			// expectStored 要求按 ID 读回的记录与 want 一致
+			expectStored := func(want *models.VersionedUser) {
+				t.Helper()
+				got, err := v.GetVersionedByID(want.ID)
+				if err != nil {
+					t.Fatalf("GetVersionedByID(%d) failed: %v", want.ID, err)
+				}
+				if *got != *want {
+					t.Errorf("stored %+v, want %+v", got, want)
+				}
+			}
+
+			user := newVersionedUser(1)
+			if err := v.InsertVersioned(user); err != nil {
+				t.Fatalf("InsertVersioned failed: %v", err)
+			}
+			if user.Version != 1 {
+				t.Errorf("Version after InsertVersioned = %d, want 1", user.Version)
+			}
+			expectStored(user)
+
+			user.Name = "renamed"
+			if err := v.UpdateIfVersion(user, 1); err != nil {
+				t.Fatalf("UpdateIfVersion failed: %v", err)
+			}
+			if user.Version != 2 {
+				t.Errorf("Version after UpdateIfVersion = %d, want 2", user.Version)
+			}
+			expectStored(user)
+
+			stale := *user
+			stale.Name = "stale"
+			if err := v.UpdateIfVersion(&stale, 1); !errors.Is(err, orm.ErrVersionConflict) {
+				t.Errorf("UpdateIfVersion with stale version returned %v, want %v", err, orm.ErrVersionConflict)
+			}
+			expectStored(user)
+
+			missing := newVersionedUser(2)
+			missing.ID = 1 << 40
+			if err := v.UpdateIfVersion(missing, 1); !errors.Is(err, orm.ErrVersionConflict) {
+				t.Errorf("UpdateIfVersion of missing user returned %v, want %v", err, orm.ErrVersionConflict)
+			}
+
+			var wg sync.WaitGroup
+			errs := make(chan error, versionWriters)
+			for w := 0; w < versionWriters; w++ {
+				wg.Add(1)
+				go func(writer int) {
+					defer wg.Done()
+					for i := 0; i < versionUpdates; i++ {
+						if _, err := updateWithRetry(v, user.ID, 1+writer); err != nil {
+							errs <- fmt.Errorf("writer %d: %w", writer, err)
+							return
+						}
+					}
+				}(w)
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				t.Error(err)
+			}
+			got, err := v.GetVersionedByID(user.ID)
+			if err != nil {
+				t.Fatalf("GetVersionedByID failed: %v", err)
+			}
+			if want := int64(2 + versionWriters*versionUpdates); got.Version != want {
+				t.Errorf("Version after concurrent updates = %d, want %d", got.Version, want)
+			}
+		})
+	}
+}
+
+// BenchmarkOptimisticLock 乐观锁条件更新：success 为单个写入者的无冲突更新；
+// conflict 为多个写入者并行地读取并更新同一行，conflicts/op 为版本冲突的比例
+func BenchmarkOptimisticLock_GORM(b *testing.B) {
+	benchmarkOptimisticLock(b, "gorm")
+}
+
+func BenchmarkOptimisticLock_XORM(b *testing.B) {
+	benchmarkOptimisticLock(b, "xorm")
+}
+
+func BenchmarkOptimisticLock_ZORM(b *testing.B) {
+	benchmarkOptimisticLock(b, "zorm")
+}
+
+func BenchmarkOptimisticLock_SQLX(b *testing.B) {
+	benchmarkOptimisticLock(b, "sqlx")
+}
+
+func BenchmarkOptimisticLock_BORM(b *testing.B) {
+	benchmarkOptimisticLock(b, "borm")
+}
+
+func BenchmarkOptimisticLock_BUN(b *testing.B) {
+	benchmarkOptimisticLock(b, "bun")
+}
+
+func BenchmarkOptimisticLock_ENT(b *testing.B) {
+	benchmarkOptimisticLock(b, "ent")
+}
+
+func BenchmarkOptimisticLock_STDLIB(b *testing.B) {
+	benchmarkOptimisticLock(b, "stdlib")
+}
+
+func BenchmarkOptimisticLock_DIRECT(b *testing.B) {
+	benchmarkOptimisticLock(b, "direct")
+}
+
+func benchmarkOptimisticLock(b *testing.B, ormName string) {
+	v, cleanup := setupVersioned(b, ormName)
+	defer cleanup()
+
+	b.Run("success", func(b *testing.B) {
+		user := newVersionedUser(0)
+		if err := v.InsertVersioned(user); err != nil {
+			b.Fatalf("InsertVersioned failed: %v", err)
+		}
+		b.ResetTimer()
+		b.ReportAllocs()
+		defer trackBenchmark(b).report()
+
+		for i := 0; i < b.N; i++ {
+			user.Age = 20 + i%50
+			if err := v.UpdateIfVersion(user, user.Version); err != nil {
+				b.Fatalf("UpdateIfVersion failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("conflict", func(b *testing.B) {
+		if sqldriver.Name() == sqldriver.NullDriverName {
+			b.Skip("null driver always reports one affected row")
+		}
+		user := newVersionedUser(0)
+		if err := v.InsertVersioned(user); err != nil {
+			b.Fatalf("InsertVersioned failed: %v", err)
+		}
+		var conflicts atomic.Int64
+		b.ResetTimer()
+		b.ReportAllocs()
+		tracker := trackBenchmark(b)
+
+		// 每次操作读取最新版本后条件更新一次，冲突不重试
+		b.RunParallel(func(pb *testing.PB) {
+			age := 20
+			for pb.Next() {
+				current, err := v.GetVersionedByID(user.ID)
+				if err != nil {
+					b.Errorf("GetVersionedByID failed: %v", err)
+					return
+				}
+				age = 20 + (age+1)%50
+				current.Age = age
+				err = v.UpdateIfVersion(current, current.Version)
+				if errors.Is(err, orm.ErrVersionConflict) {
+					conflicts.Add(1)
+				} else if err != nil {
+					b.Errorf("UpdateIfVersion failed: %v", err)
+					return
+				}
+			}
+		})
+
+		b.StopTimer()
+		tracker.report()
+		// 每次成功的更新恰好使版本号加一
+		got, err := v.GetVersionedByID(user.ID)
+		if err != nil {
+			b.Fatalf("GetVersionedByID failed: %v", err)
+		}
+		if want := 1 + int64(b.N) - conflicts.Load(); got.Version != want {
+			b.Errorf("Version = %d after %d updates with %d conflicts, want %d", got.Version, b.N, conflicts.Load(), want)
+		}
+		b.ReportMetric(float64(conflicts.Load())/float64(b.N), "conflicts/op")
+	})
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+// setupWide 初始化 ORM 并创建宽表
+func setupWide(b *testing.B, ormName string) (orm.WideInterface, func()) {
+	return setupCapability(b, ormName, orm.WideInterface.CreateWideTables, orm.WideInterface.DropWideTables)
+}
+
+// BenchmarkWideInsert 宽表单条插入测试（列数梯度）
+func BenchmarkWideInsert_GORM(b *testing.B) {
+	benchmarkWideInsert(b, "gorm")
+}
+
+func BenchmarkWideInsert_XORM(b *testing.B) {
+	benchmarkWideInsert(b, "xorm")
+}
+
+func BenchmarkWideInsert_ZORM(b *testing.B) {
+	benchmarkWideInsert(b, "zorm")
+}
+
+func BenchmarkWideInsert_SQLX(b *testing.B) {
+	benchmarkWideInsert(b, "sqlx")
+}
+
+func BenchmarkWideInsert_BORM(b *testing.B) {
+	benchmarkWideInsert(b, "borm")
+}
+
+func BenchmarkWideInsert_BUN(b *testing.B) {
+	benchmarkWideInsert(b, "bun")
+}
+
+func BenchmarkWideInsert_ENT(b *testing.B) {
+	benchmarkWideInsert(b, "ent")
+}
+
+func BenchmarkWideInsert_STDLIB(b *testing.B) {
+	benchmarkWideInsert(b, "stdlib")
+}
+
+func benchmarkWideInsert(b *testing.B, ormName string) {
+	wide, cleanup := setupWide(b, ormName)
+	defer cleanup()
+
+	for _, cols := range models.WideWidths {
+		b.Run(fmt.Sprintf("cols=%d", cols), func(b *testing.B) {
+			b.ReportAllocs()
+			defer trackBenchmark(b).report()
+			for i := 0; i < b.N; i++ {
+				row := models.NewWide(cols)
+				row.Fill(i)
+				if err := wide.InsertWide(row); err != nil {
+					b.Fatalf("InsertWide failed: %v", err)
+				}
+			}
+			// 每列的平均耗时，用于观察列数增长时的单列成本
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*cols), "ns/field")
+		})
+	}
+}
+
+// BenchmarkWideSelect 宽表分页查询测试（列数梯度）
+func BenchmarkWideSelect_GORM(b *testing.B) {
+	benchmarkWideSelect(b, "gorm")
+}
+
+func BenchmarkWideSelect_XORM(b *testing.B) {
+	benchmarkWideSelect(b, "xorm")
+}
+
+func BenchmarkWideSelect_ZORM(b *testing.B) {
+	benchmarkWideSelect(b, "zorm")
+}
+
+func BenchmarkWideSelect_SQLX(b *testing.B) {
+	benchmarkWideSelect(b, "sqlx")
+}
+
+func BenchmarkWideSelect_BORM(b *testing.B) {
+	benchmarkWideSelect(b, "borm")
+}
+
+func BenchmarkWideSelect_BUN(b *testing.B) {
+	benchmarkWideSelect(b, "bun")
+}
+
+func BenchmarkWideSelect_ENT(b *testing.B) {
+	benchmarkWideSelect(b, "ent")
+}
+
+func BenchmarkWideSelect_STDLIB(b *testing.B) {
+	benchmarkWideSelect(b, "stdlib")
+}
+
+func benchmarkWideSelect(b *testing.B, ormName string) {
+	wide, cleanup := setupWide(b, ormName)
+	defer cleanup()
+
+	limit := 100
+	for _, cols := range models.WideWidths {
+		// 预先插入一些数据
+		for i := 0; i < 1000; i++ {
+			row := models.NewWide(cols)
+			row.Fill(i)
+			if err := wide.InsertWide(row); err != nil {
+				b.Fatalf("Pre-insert failed: %v", err)
+			}
+		}
+
+		b.Run(fmt.Sprintf("cols=%d", cols), func(b *testing.B) {
+			b.ReportAllocs()
+			defer trackBenchmark(b).report()
+			for i := 0; i < b.N; i++ {
+				offset := (i * limit) % 900
+				rows, err := wide.GetWide(cols, limit, offset)
+				if err != nil {
+					b.Fatalf("GetWide failed: %v", err)
+				}
+				if len(rows) != limit {
+					b.Fatalf("GetWide returned %d rows, want %d", len(rows), limit)
+				}
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*limit*cols), "ns/field")
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package xorm
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// hookCalls hookUser 的钩子被调用的累计次数，钩子是模型方法，由本包所有实例共享
+var hookCalls atomic.Int64
+
+// hookUser 与 models.User 结构相同、定义了 XORM 处理器（钩子）的模型，钩子只计数
+type hookUser models.User
+
+func (hookUser) TableName() string {
+	return models.HookTable
+}
+
+func (*hookUser) BeforeInsert() {
+	hookCalls.Add(1)
+}
+
+func (*hookUser) AfterInsert() {
+	hookCalls.Add(1)
+}
+
+func (*hookUser) BeforeUpdate() {
+	hookCalls.Add(1)
+}
+
+func (*hookUser) AfterUpdate() {
+	hookCalls.Add(1)
+}
+
+// AfterLoad XORM 没有查询前的钩子
+func (*hookUser) AfterLoad() {
+	hookCalls.Add(1)
+}
+
+func (x *XormORM) CreateHookTable() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&hookUser{})
+}
+
+func (x *XormORM) DropHookTable() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&hookUser{})
+}
+
+func (x *XormORM) InsertHooked(user *models.User) error {
+	defer x.lockCache()()
+	_, err := x.engine.Insert((*hookUser)(user))
+	return err
+}
+
+func (x *XormORM) UpdateHooked(user *models.User) error {
+	defer x.lockCache()()
+	_, err := x.engine.ID(user.ID).AllCols().Update((*hookUser)(user))
+	return err
+}
+
+func (x *XormORM) GetHookedByID(id int64) (*models.User, error) {
+	defer x.lockCache()()
+	user := &hookUser{}
+	has, err := x.engine.ID(id).Get(user)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("user not found")
+	}
+	return (*models.User)(user), nil
+}
+
+func (x *XormORM) HookCalls() int64 {
+	return hookCalls.Load()
+}
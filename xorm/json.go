@@ -0,0 +1,43 @@
+package xorm
+
+import (
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (x *XormORM) CreateJSONTable() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&models.Profile{})
+}
+
+func (x *XormORM) DropJSONTable() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&models.Profile{})
+}
+
+func (x *XormORM) InsertProfile(profile *models.Profile) error {
+	defer x.lockCache()()
+	_, err := x.engine.Insert(profile)
+	return err
+}
+
+func (x *XormORM) GetProfileByID(id int64) (*models.Profile, error) {
+	defer x.lockCache()()
+	profile := &models.Profile{}
+	has, err := x.engine.ID(id).Get(profile)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("profile not found")
+	}
+	return profile, nil
+}
+
+func (x *XormORM) GetProfilesByTheme(theme string) ([]*models.Profile, error) {
+	defer x.lockCache()()
+	var profiles []*models.Profile
+	err := x.engine.Where("json_extract(settings, '$.theme') = ?", theme).Asc("id").Find(&profiles)
+	return profiles, err
+}
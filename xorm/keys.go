@@ -0,0 +1,112 @@
+package xorm
+
+import (
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
+
+// textUUID 以 36 个字符的文本读写 uuid.UUID；xorm 默认把数组类型编码为 JSON，不调用其 driver.Valuer
+type textUUID uuid.UUID
+
+func (u *textUUID) FromDB(data []byte) error {
+	id, err := uuid.ParseBytes(data)
+	if err != nil {
+		return err
+	}
+	*u = textUUID(id)
+	return nil
+}
+
+func (u *textUUID) ToDB() ([]byte, error) {
+	return []byte(uuid.UUID(*u).String()), nil
+}
+
+// uuidUser 嵌入 models.UUIDUser，主键列改用 textUUID
+type uuidUser struct {
+	ID              textUUID `xorm:"pk varchar(36) 'id'"`
+	models.UUIDUser `xorm:"extends"`
+}
+
+func (uuidUser) TableName() string {
+	return models.UUIDUser{}.TableName()
+}
+
+func newUUIDUser(user *models.UUIDUser) *uuidUser {
+	return &uuidUser{ID: textUUID(user.ID), UUIDUser: *user}
+}
+
+func (x *XormORM) CreateKeyTables() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&uuidUser{}, &models.SnowflakeUser{})
+}
+
+func (x *XormORM) DropKeyTables() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&uuidUser{}, &models.SnowflakeUser{})
+}
+
+func (x *XormORM) InsertUUIDUser(user *models.UUIDUser) error {
+	defer x.lockCache()()
+	_, err := x.engine.Insert(newUUIDUser(user))
+	return err
+}
+
+// InsertUUIDBatch 主键由客户端生成，单条多行 INSERT 后无需再查询 last_insert_rowid
+func (x *XormORM) InsertUUIDBatch(users []*models.UUIDUser) error {
+	defer x.lockCache()()
+	if len(users) == 0 {
+		return nil
+	}
+	rows := make([]*uuidUser, len(users))
+	for i, user := range users {
+		rows[i] = newUUIDUser(user)
+	}
+	_, err := x.engine.Insert(rows)
+	return err
+}
+
+func (x *XormORM) GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error) {
+	defer x.lockCache()()
+	row := &uuidUser{}
+	has, err := x.engine.ID(id.String()).Get(row)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("user not found")
+	}
+	// 启用缓存时 row 本身存入缓存，返回副本
+	user := row.UUIDUser
+	user.ID = uuid.UUID(row.ID)
+	return &user, nil
+}
+
+func (x *XormORM) InsertSnowflakeUser(user *models.SnowflakeUser) error {
+	defer x.lockCache()()
+	_, err := x.engine.Insert(user)
+	return err
+}
+
+func (x *XormORM) InsertSnowflakeBatch(users []*models.SnowflakeUser) error {
+	defer x.lockCache()()
+	if len(users) == 0 {
+		return nil
+	}
+	_, err := x.engine.Insert(users)
+	return err
+}
+
+func (x *XormORM) GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error) {
+	defer x.lockCache()()
+	user := &models.SnowflakeUser{}
+	has, err := x.engine.ID(id).Get(user)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("user not found")
+	}
+	return user, nil
+}
@@ -0,0 +1,19 @@
+package xorm
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (x *XormORM) CreateMigrateTable() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&models.MigrateUser{})
+}
+
+func (x *XormORM) DropMigrateTable() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&models.MigrateUser{})
+}
+
+// Migrate 以目标结构调用 Sync2，由 XORM 比对现有表结构后新增列和索引
+func (x *XormORM) Migrate(step models.MigrateStep) error {
+	defer x.lockCache()()
+	return x.engine.Sync2(step.Model())
+}
@@ -0,0 +1,57 @@
+package xorm
+
+import (
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (x *XormORM) CreateNullableTable() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&models.NullableUser{})
+}
+
+func (x *XormORM) DropNullableTable() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&models.NullableUser{})
+}
+
+func (x *XormORM) InsertNullable(user *models.NullableUser) error {
+	defer x.lockCache()()
+	_, err := x.engine.Insert(user)
+	return err
+}
+
+func (x *XormORM) UpdateNullable(user *models.NullableUser) error {
+	defer x.lockCache()()
+	// xorm 默认跳过 nil 和零值字段，AllCols 才会写回 NULL 和零值
+	_, err := x.engine.ID(user.ID).AllCols().Update(user)
+	return err
+}
+
+func (x *XormORM) GetNullableByID(id int64) (*models.NullableUser, error) {
+	defer x.lockCache()()
+	user := &models.NullableUser{}
+	has, err := x.engine.ID(id).Get(user)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("user not found")
+	}
+	return user, nil
+}
+
+func (x *XormORM) GetNullableAll(limit, offset int) ([]*models.NullableUser, error) {
+	defer x.lockCache()()
+	var users []*models.NullableUser
+	err := x.engine.Asc("id").Limit(limit, offset).Find(&users)
+	return users, err
+}
+
+func (x *XormORM) GetPlainAll(limit, offset int) ([]*models.PlainUser, error) {
+	defer x.lockCache()()
+	var users []*models.PlainUser
+	err := x.engine.Asc("id").Limit(limit, offset).Find(&users)
+	return users, err
+}
@@ -0,0 +1,42 @@
+package xorm
+
+import (
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (x *XormORM) CreatePostTable() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&models.Post{})
+}
+
+func (x *XormORM) DropPostTable() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&models.Post{})
+}
+
+func (x *XormORM) InsertPost(post *models.Post) error {
+	defer x.lockCache()()
+	_, err := x.engine.Insert(post)
+	return err
+}
+
+func (x *XormORM) GetPostByID(id int64) (*models.Post, error) {
+	defer x.lockCache()()
+	post := &models.Post{}
+	has, err := x.engine.ID(id).Get(post)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("post not found")
+	}
+	return post, nil
+}
+
+func (x *XormORM) DeletePost(id int64) error {
+	defer x.lockCache()()
+	_, err := x.engine.ID(id).Delete(&models.Post{})
+	return err
+}
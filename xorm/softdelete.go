@@ -0,0 +1,77 @@
+package xorm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// softUser 嵌入 models.User 并带 deleted 标记的字段：Delete 改为设置 deleted_at，
+// 查询和计数自动排除 deleted_at 非空的记录
+type softUser struct {
+	models.User `xorm:"extends"`
+	DeletedAt   time.Time `xorm:"deleted index 'deleted_at'"`
+}
+
+func (softUser) TableName() string {
+	return models.SoftDeleteTable
+}
+
+func (x *XormORM) CreateSoftUserTable() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&softUser{})
+}
+
+func (x *XormORM) DropSoftUserTable() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&softUser{})
+}
+
+func (x *XormORM) InsertSoftUser(user *models.User) error {
+	defer x.lockCache()()
+	row := &softUser{User: *user}
+	if _, err := x.engine.Insert(row); err != nil {
+		return err
+	}
+	user.ID = row.ID
+	return nil
+}
+
+func (x *XormORM) DeleteSoftUser(id int64) error {
+	defer x.lockCache()()
+	_, err := x.engine.ID(id).Delete(&softUser{})
+	return err
+}
+
+func (x *XormORM) GetSoftUserByID(id int64) (*models.User, error) {
+	defer x.lockCache()()
+	row := &softUser{}
+	has, err := x.engine.ID(id).Get(row)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("user not found")
+	}
+	return &row.User, nil
+}
+
+func (x *XormORM) CountSoftUsers() (int64, error) {
+	defer x.lockCache()()
+	return x.engine.Count(&softUser{})
+}
+
+func (x *XormORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	defer x.lockCache()()
+	var rows []softUser
+	err := x.engine.Asc("id").Limit(limit, offset).Find(&rows)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*models.User, len(rows))
+	for i := range rows {
+		users[i] = &rows[i].User
+	}
+	return users, nil
+}
@@ -0,0 +1,113 @@
+package xorm
+
+import (
+	"strings"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// userTagRow 连接 user_tags 和 tags 查询到的一行：所属用户的 ID 和标签
+type userTagRow struct {
+	UserID int64  `xorm:"'user_id'"`
+	TagID  int64  `xorm:"'tag_id'"`
+	Name   string `xorm:"'name'"`
+}
+
+func (x *XormORM) CreateTagTables() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&models.Tag{}, &models.UserTag{})
+}
+
+func (x *XormORM) DropTagTables() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&models.UserTag{}, &models.Tag{})
+}
+
+func (x *XormORM) InsertTags(tags []*models.Tag) error {
+	defer x.lockCache()()
+	if len(tags) == 0 {
+		return nil
+	}
+	sess := x.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+	if _, err := sess.Insert(tags); err != nil {
+		return err
+	}
+	var lastID int64
+	if _, err := sess.SQL("SELECT last_insert_rowid()").Get(&lastID); err != nil {
+		return err
+	}
+	firstID := lastID - int64(len(tags)) + 1
+	for i, tag := range tags {
+		tag.ID = firstID + int64(i)
+	}
+	return sess.Commit()
+}
+
+// AttachTags xorm 的 Insert 不支持 ON CONFLICT，以原生 SQL 忽略已有的关联
+func (x *XormORM) AttachTags(userID int64, tagIDs []int64) error {
+	defer x.lockCache()()
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, 1+len(tagIDs)*2)
+	args = append(args, "INSERT INTO user_tags (user_id, tag_id) VALUES (?, ?)"+strings.Repeat(", (?, ?)", len(tagIDs)-1)+" ON CONFLICT DO NOTHING")
+	for _, tagID := range tagIDs {
+		args = append(args, userID, tagID)
+	}
+	_, err := x.engine.Exec(args...)
+	return err
+}
+
+// GetUsersWithTags 先分页查询用户，再以 JOIN 查询这些用户的标签
+func (x *XormORM) GetUsersWithTags(limit, offset int) ([]*models.TaggedUser, error) {
+	defer x.lockCache()()
+	var users []*models.User
+	if err := x.engine.Asc("id").Limit(limit, offset).Find(&users); err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return []*models.TaggedUser{}, nil
+	}
+
+	result := make([]*models.TaggedUser, len(users))
+	byID := make(map[int64]*models.TaggedUser, len(users))
+	ids := make([]int64, len(users))
+	for i, user := range users {
+		result[i] = &models.TaggedUser{User: *user}
+		byID[user.ID] = result[i]
+		ids[i] = user.ID
+	}
+
+	var rows []userTagRow
+	err := x.engine.Table("user_tags").
+		Select("user_tags.user_id, user_tags.tag_id, tags.name").
+		Join("INNER", "tags", "tags.id = user_tags.tag_id").
+		In("user_tags.user_id", ids).
+		Asc("user_tags.user_id", "user_tags.tag_id").
+		Find(&rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		user := byID[row.UserID]
+		user.Tags = append(user.Tags, &models.Tag{ID: row.TagID, Name: row.Name})
+	}
+	return result, nil
+}
+
+func (x *XormORM) GetUsersByTag(name string) ([]*models.User, error) {
+	defer x.lockCache()()
+	var users []*models.User
+	err := x.engine.Table("users").
+		Select("users.*").
+		Join("INNER", "user_tags", "user_tags.user_id = users.id").
+		Join("INNER", "tags", "tags.id = user_tags.tag_id").
+		Where("tags.name = ?", name).
+		Asc("users.id").
+		Find(&users)
+	return users, err
+}
@@ -0,0 +1,55 @@
+package xorm
+
+import (
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/benchplus/goorm/internal/orm"
+)
+
+func (x *XormORM) CreateVersionedTable() error {
+	defer x.lockCache()()
+	return x.engine.Sync2(&models.VersionedUser{})
+}
+
+func (x *XormORM) DropVersionedTable() error {
+	defer x.lockCache()()
+	return x.engine.DropTables(&models.VersionedUser{})
+}
+
+// InsertVersioned version 标记的字段由 XORM 在插入时置为 1
+func (x *XormORM) InsertVersioned(user *models.VersionedUser) error {
+	defer x.lockCache()()
+	_, err := x.engine.Insert(user)
+	return err
+}
+
+// UpdateIfVersion XORM 按 bean 中的版本号追加 version = ? 条件，并把版本号加一
+func (x *XormORM) UpdateIfVersion(user *models.VersionedUser, expectedVersion int64) error {
+	defer x.lockCache()()
+	user.Version = expectedVersion
+	affected, err := x.engine.ID(user.ID).Cols("name", "email", "age").Update(user)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return orm.ErrVersionConflict
+	}
+	user.Version = expectedVersion + 1
+	return nil
+}
+
+func (x *XormORM) GetVersionedByID(id int64) (*models.VersionedUser, error) {
+	defer x.lockCache()()
+	user := &models.VersionedUser{}
+	has, err := x.engine.ID(id).Get(user)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("user not found")
+	}
+	// 启用缓存时 Get 把传入的 bean 本身放入缓存；返回副本，调用方修改后再条件更新不会改动缓存
+	copied := *user
+	return &copied, nil
+}
@@ -0,0 +1,36 @@
+package xorm
+
+import "github.com/benchplus/goorm/internal/models"
+
+func (x *XormORM) CreateWideTables() error {
+	defer x.lockCache()()
+	for _, n := range models.WideWidths {
+		if err := x.engine.Sync2(models.NewWide(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *XormORM) DropWideTables() error {
+	defer x.lockCache()()
+	for _, n := range models.WideWidths {
+		if err := x.engine.DropTables(models.NewWide(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *XormORM) InsertWide(row models.Wide) error {
+	defer x.lockCache()()
+	_, err := x.engine.Insert(row)
+	return err
+}
+
+func (x *XormORM) GetWide(cols, limit, offset int) ([]models.Wide, error) {
+	defer x.lockCache()()
+	rows := models.NewWideSlice(cols)
+	err := x.engine.Asc("id").Limit(limit, offset).Find(rows)
+	return models.WideRows(rows), err
+}
@@ -3,53 +3,121 @@ package xorm
 import (
 	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/benchplus/goorm/internal/models"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/benchplus/goorm/internal/sqldriver"
 	"xorm.io/xorm"
+	"xorm.io/xorm/caches"
+	"xorm.io/xorm/core"
+	"xorm.io/xorm/dialects"
 )
 
+// timeLayout xorm 写入 datetime(6) 列的格式。范围条件按同一格式传参，
+// 直接传 time.Time 时驱动会追加时区偏移，恰好落在边界上的值按字符串比较会判错
+const timeLayout = "2006-01-02 15:04:05.000000"
+
 type XormORM struct {
 	engine *xorm.Engine
+	// cacheSize 大于 0 时启用 LRU 缓存，缓存的最大元素个数
+	cacheSize int
+	// cacheMu 启用缓存时串行化所有操作，见 lockCache
+	cacheMu sync.Mutex
 }
 
 func New() *XormORM {
 	return &XormORM{}
 }
 
+// NewWithCache 创建启用 xorm LRU 缓存（内存存储，最多 size 个元素）的实现
+func NewWithCache(size int) *XormORM {
+	return &XormORM{cacheSize: size}
+}
+
 func (x *XormORM) Init(dsn string) error {
-	var err error
-	x.engine, err = xorm.NewEngine("sqlite3", dsn)
+	// 方言按 sqlite3 解析，连接通过带统计的驱动打开
+	dialect, err := dialects.OpenDialect("sqlite3", dsn)
+	if err != nil {
+		return err
+	}
+	db, err := core.Open(sqldriver.Name(), dsn)
+	if err != nil {
+		return err
+	}
+	x.engine, err = xorm.NewEngineWithDialectAndDB("sqlite3", dsn, dialect, db)
 	if err != nil {
 		return err
 	}
-	x.engine.SetMaxOpenConns(1)
+	// xorm 默认按本地时区格式化且不带偏移，统一为 UTC
+	x.engine.DatabaseTZ = time.UTC
+	x.engine.TZLocation = time.UTC
+	if x.cacheSize > 0 {
+		x.engine.SetDefaultCacher(caches.NewLRUCacher(caches.NewMemoryStore(), x.cacheSize))
+	}
 	return nil
 }
 
+// lockCache 启用缓存时加锁并返回解锁函数，未启用时不加锁。
+// 启用缓存后一次操作可能占用两个连接：查询未命中时保持 ID 查询的 rows 打开再按 ID 取行。
+// 多个 goroutine 并发时，持有读锁的 rows 与另一个连接上的写互相等待，直到 busy_timeout
+// 后返回 database is locked，所以同一时间只执行一个操作
+func (x *XormORM) lockCache() func() {
+	if x.cacheSize == 0 {
+		return func() {}
+	}
+	x.cacheMu.Lock()
+	return x.cacheMu.Unlock
+}
+
 func (x *XormORM) Close() error {
 	return x.engine.Close()
 }
 
 func (x *XormORM) CreateTable() error {
+	defer x.lockCache()()
 	return x.engine.Sync2(&models.User{})
 }
 
 func (x *XormORM) DropTable() error {
+	defer x.lockCache()()
 	return x.engine.DropTables(&models.User{})
 }
 
 func (x *XormORM) Insert(user *models.User) error {
+	defer x.lockCache()()
 	_, err := x.engine.Insert(user)
 	return err
 }
 
+// InsertBatch 以一条多行 INSERT 插入。XORM 不回填批量插入的 ID，在同一事务内读取
+// last_insert_rowid（最后一行的 ID，单语句内自增 ID 连续）后依次赋值
 func (x *XormORM) InsertBatch(users []*models.User) error {
-	_, err := x.engine.Insert(users)
-	return err
+	defer x.lockCache()()
+	if len(users) == 0 {
+		return nil
+	}
+	sess := x.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+	if _, err := sess.Insert(users); err != nil {
+		return err
+	}
+	var lastID int64
+	if _, err := sess.SQL("SELECT last_insert_rowid()").Get(&lastID); err != nil {
+		return err
+	}
+	firstID := lastID - int64(len(users)) + 1
+	for i, user := range users {
+		user.ID = firstID + int64(i)
+	}
+	return sess.Commit()
 }
 
 func (x *XormORM) GetByID(id int64) (*models.User, error) {
+	defer x.lockCache()()
 	user := &models.User{}
 	has, err := x.engine.ID(id).Get(user)
 	if err != nil {
@@ -62,31 +130,62 @@ func (x *XormORM) GetByID(id int64) (*models.User, error) {
 }
 
 func (x *XormORM) GetByIDs(ids []int64) ([]*models.User, error) {
+	defer x.lockCache()()
 	var users []*models.User
 	err := x.engine.In("id", ids).Find(&users)
 	return users, err
 }
 
 func (x *XormORM) Update(user *models.User) error {
-	_, err := x.engine.ID(user.ID).Update(user)
+	defer x.lockCache()()
+	// 默认跳过零值字段，AllCols 使空字符串和 0 也写入，与其他实现的整行更新一致
+	_, err := x.engine.ID(user.ID).AllCols().Update(user)
 	return err
 }
 
 func (x *XormORM) Delete(id int64) error {
+	defer x.lockCache()()
 	_, err := x.engine.ID(id).Delete(&models.User{})
 	return err
 }
 
 func (x *XormORM) Count() (int64, error) {
+	defer x.lockCache()()
 	return x.engine.Count(&models.User{})
 }
 
 func (x *XormORM) GetAll(limit, offset int) ([]*models.User, error) {
+	defer x.lockCache()()
+	var users []*models.User
+	// 启用缓存时先执行 SELECT id ... LIMIT，不排序的话 SQLite 会改用 created_at 上的覆盖索引，
+	// 分页顺序随之变为 created_at 顺序
+	err := x.engine.Asc("id").Limit(limit, offset).Find(&users)
+	return users, err
+}
+
+func (x *XormORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	defer x.lockCache()()
+	var users []*models.User
+	err := x.engine.Where("created_at >= ? AND created_at < ?", from.UTC().Format(timeLayout), to.UTC().Format(timeLayout)).
+		Asc("created_at").
+		Find(&users)
+	return users, err
+}
+
+func (x *XormORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	defer x.lockCache()()
 	var users []*models.User
-	err := x.engine.Limit(limit, offset).Find(&users)
+	err := x.engine.SQL(query, args...).Find(&users)
 	return users, err
 }
 
+func (x *XormORM) Iterate(fn func(*models.User) error) error {
+	defer x.lockCache()()
+	return x.engine.Iterate(new(models.User), func(_ int, bean interface{}) error {
+		return fn(bean.(*models.User))
+	})
+}
+
 // GetDSN 生成测试用的 DSN
 func GetDSN() string {
 	return getTempFile()
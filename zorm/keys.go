@@ -0,0 +1,120 @@
+package zorm
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/benchplus/goorm/internal/models"
+	"github.com/google/uuid"
+)
+
+// keyTableStatements uuid_users 和 snowflake_users 的建表语句
+var keyTableStatements = []string{
+	`
+		CREATE TABLE IF NOT EXISTS uuid_users (
+			id VARCHAR(36) PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_uuid_users_created_at ON uuid_users (created_at)`, `
+		CREATE TABLE IF NOT EXISTS snowflake_users (
+			id INTEGER PRIMARY KEY,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_snowflake_users_created_at ON snowflake_users (created_at)`,
+}
+
+func (zo *ZormORM) CreateKeyTables() error {
+	for _, statement := range keyTableStatements {
+		if _, err := zo.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (zo *ZormORM) DropKeyTables() error {
+	if _, err := zo.db.Exec("DROP TABLE IF EXISTS uuid_users"); err != nil {
+		return err
+	}
+	_, err := zo.db.Exec("DROP TABLE IF EXISTS snowflake_users")
+	return err
+}
+
+func (zo *ZormORM) InsertUUIDUser(user *models.UUIDUser) error {
+	_, err := zo.db.Exec(`INSERT INTO uuid_users (id, name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	return err
+}
+
+func (zo *ZormORM) InsertUUIDBatch(users []*models.UUIDUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	// 多行 INSERT，主键由客户端生成，无需读取 LastInsertId
+	args := make([]interface{}, 0, len(users)*6)
+	placeholders := make([]string, 0, len(users))
+	for _, user := range users {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+		args = append(args, user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	}
+	_, err := zo.db.Exec(`INSERT INTO uuid_users (id, name, email, age, created_at, updated_at) VALUES `+strings.Join(placeholders, ", "), args...)
+	return err
+}
+
+func (zo *ZormORM) GetUUIDUserByID(id uuid.UUID) (*models.UUIDUser, error) {
+	user := &models.UUIDUser{}
+	err := zo.db.QueryRow("SELECT id, name, email, age, created_at, updated_at FROM uuid_users WHERE id = ?", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (zo *ZormORM) InsertSnowflakeUser(user *models.SnowflakeUser) error {
+	_, err := zo.db.Exec(`INSERT INTO snowflake_users (id, name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	return err
+}
+
+func (zo *ZormORM) InsertSnowflakeBatch(users []*models.SnowflakeUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	// 多行 INSERT，主键由客户端生成，无需读取 LastInsertId
+	args := make([]interface{}, 0, len(users)*6)
+	placeholders := make([]string, 0, len(users))
+	for _, user := range users {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+		args = append(args, user.ID, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	}
+	_, err := zo.db.Exec(`INSERT INTO snowflake_users (id, name, email, age, created_at, updated_at) VALUES `+strings.Join(placeholders, ", "), args...)
+	return err
+}
+
+func (zo *ZormORM) GetSnowflakeUserByID(id int64) (*models.SnowflakeUser, error) {
+	user := &models.SnowflakeUser{}
+	err := zo.db.QueryRow("SELECT id, name, email, age, created_at, updated_at FROM snowflake_users WHERE id = ?", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,92 @@
+package zorm
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (zo *ZormORM) CreateNullableTable() error {
+	_, err := zo.db.Exec(`
+		CREATE TABLE IF NOT EXISTS nullable_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100),
+			email VARCHAR(100),
+			age INTEGER
+		)
+	`)
+	return err
+}
+
+func (zo *ZormORM) DropNullableTable() error {
+	_, err := zo.db.Exec("DROP TABLE IF EXISTS nullable_users")
+	return err
+}
+
+func (zo *ZormORM) InsertNullable(user *models.NullableUser) error {
+	result, err := zo.db.Exec(`INSERT INTO nullable_users (name, email, age) VALUES (?, ?, ?)`, user.Name, user.Email, user.Age)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+func (zo *ZormORM) UpdateNullable(user *models.NullableUser) error {
+	_, err := zo.db.Exec(`UPDATE nullable_users SET name = ?, email = ?, age = ? WHERE id = ?`, user.Name, user.Email, user.Age, user.ID)
+	return err
+}
+
+func (zo *ZormORM) GetNullableByID(id int64) (*models.NullableUser, error) {
+	user := &models.NullableUser{}
+	err := zo.db.QueryRow("SELECT id, name, email, age FROM nullable_users WHERE id = ?", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (zo *ZormORM) GetNullableAll(limit, offset int) ([]*models.NullableUser, error) {
+	rows, err := zo.db.Query("SELECT id, name, email, age FROM nullable_users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.NullableUser
+	for rows.Next() {
+		var user models.NullableUser
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (zo *ZormORM) GetPlainAll(limit, offset int) ([]*models.PlainUser, error) {
+	rows, err := zo.db.Query("SELECT id, name, email, age FROM nullable_users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.PlainUser
+	for rows.Next() {
+		var user models.PlainUser
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,94 @@
+package zorm
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+func (zo *ZormORM) CreateSoftUserTable() error {
+	_, err := zo.db.Exec(`
+		CREATE TABLE IF NOT EXISTS soft_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(100) NOT NULL,
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME
+		)
+	`)
+	if err != nil {
+		return err
+	}
+	_, err = zo.db.Exec(`CREATE INDEX IF NOT EXISTS idx_soft_users_created_at ON soft_users (created_at)`)
+	if err != nil {
+		return err
+	}
+	_, err = zo.db.Exec(`CREATE INDEX IF NOT EXISTS idx_soft_users_deleted_at ON soft_users (deleted_at)`)
+	return err
+}
+
+func (zo *ZormORM) DropSoftUserTable() error {
+	_, err := zo.db.Exec("DROP TABLE IF EXISTS soft_users")
+	return err
+}
+
+func (zo *ZormORM) InsertSoftUser(user *models.User) error {
+	result, err := zo.db.Exec(`INSERT INTO soft_users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	return nil
+}
+
+// DeleteSoftUser 已删除的记录不再更新，保留第一次删除的时间
+func (zo *ZormORM) DeleteSoftUser(id int64) error {
+	_, err := zo.db.Exec("UPDATE soft_users SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL", time.Now().UTC(), id)
+	return err
+}
+
+func (zo *ZormORM) GetSoftUserByID(id int64) (*models.User, error) {
+	user := &models.User{}
+	err := zo.db.QueryRow("SELECT id, name, email, age, created_at, updated_at FROM soft_users WHERE id = ? AND deleted_at IS NULL", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (zo *ZormORM) CountSoftUsers() (int64, error) {
+	var count int64
+	err := zo.db.QueryRow("SELECT COUNT(*) FROM soft_users WHERE deleted_at IS NULL").Scan(&count)
+	return count, err
+}
+
+func (zo *ZormORM) GetSoftUsers(limit, offset int) ([]*models.User, error) {
+	rows, err := zo.db.Query("SELECT id, name, email, age, created_at, updated_at FROM soft_users WHERE deleted_at IS NULL ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*models.User, 0, limit)
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,139 @@
+package zorm
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/benchplus/goorm/internal/models"
+)
+
+// tagTableStatements tags 和 user_tags 的建表语句
+var tagTableStatements = []string{
+	`
+		CREATE TABLE IF NOT EXISTS tags (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(100) NOT NULL UNIQUE
+		)
+	`, `
+		CREATE TABLE IF NOT EXISTS user_tags (
+			user_id INTEGER NOT NULL,
+			tag_id INTEGER NOT NULL,
+			PRIMARY KEY (user_id, tag_id)
+		)
+	`, `CREATE INDEX IF NOT EXISTS idx_user_tags_tag_id ON user_tags (tag_id)`,
+}
+
+// usersWithTagsQuery 分页查询用户并左连接其标签，每个用户的行相邻，没有标签的用户只有一行且标签列为 NULL
+const usersWithTagsQuery = `
+	SELECT u.id, u.name, u.email, u.age, u.created_at, u.updated_at, t.id, t.name
+	FROM (SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?) AS u
+	LEFT JOIN user_tags AS ut ON ut.user_id = u.id
+	LEFT JOIN tags AS t ON t.id = ut.tag_id
+	ORDER BY u.id, t.id
+`
+
+func (zo *ZormORM) CreateTagTables() error {
+	for _, statement := range tagTableStatements {
+		if _, err := zo.db.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (zo *ZormORM) DropTagTables() error {
+	if _, err := zo.db.Exec("DROP TABLE IF EXISTS user_tags"); err != nil {
+		return err
+	}
+	_, err := zo.db.Exec("DROP TABLE IF EXISTS tags")
+	return err
+}
+
+func (zo *ZormORM) InsertTags(tags []*models.Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, len(tags))
+	for i, tag := range tags {
+		args[i] = tag.Name
+	}
+	result, err := zo.db.Exec("INSERT INTO tags (name) VALUES (?)"+strings.Repeat(", (?)", len(tags)-1), args...)
+	if err != nil {
+		return err
+	}
+
+	// 多行插入时 last_insert_rowid 为最后一行的 ID，单语句内自增 ID 连续
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	firstID := lastID - int64(len(tags)) + 1
+	for i, tag := range tags {
+		tag.ID = firstID + int64(i)
+	}
+	return nil
+}
+
+func (zo *ZormORM) AttachTags(userID int64, tagIDs []int64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(tagIDs)*2)
+	for _, tagID := range tagIDs {
+		args = append(args, userID, tagID)
+	}
+	_, err := zo.db.Exec("INSERT INTO user_tags (user_id, tag_id) VALUES (?, ?)"+strings.Repeat(", (?, ?)", len(tagIDs)-1)+" ON CONFLICT DO NOTHING", args...)
+	return err
+}
+
+func (zo *ZormORM) GetUsersWithTags(limit, offset int) ([]*models.TaggedUser, error) {
+	rows, err := zo.db.Query(usersWithTagsQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*models.TaggedUser, 0, limit)
+	for rows.Next() {
+		var user models.User
+		var tagID sql.NullInt64
+		var tagName sql.NullString
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt, &tagID, &tagName); err != nil {
+			return nil, err
+		}
+		if len(users) == 0 || users[len(users)-1].ID != user.ID {
+			users = append(users, &models.TaggedUser{User: user})
+		}
+		if tagID.Valid {
+			last := users[len(users)-1]
+			last.Tags = append(last.Tags, &models.Tag{ID: tagID.Int64, Name: tagName.String})
+		}
+	}
+	return users, rows.Err()
+}
+
+func (zo *ZormORM) GetUsersByTag(name string) ([]*models.User, error) {
+	rows, err := zo.db.Query(`
+		SELECT users.id, users.name, users.email, users.age, users.created_at, users.updated_at
+		FROM users
+		JOIN user_tags ON user_tags.user_id = users.id
+		JOIN tags ON tags.id = user_tags.tag_id
+		WHERE tags.name = ?
+		ORDER BY users.id
+	`, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
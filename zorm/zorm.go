@@ -5,17 +5,18 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/benchplus/goorm/internal/models"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/benchplus/goorm/internal/sqldriver"
 )
 
 type ZormORM struct {
-	db          *sql.DB
-	insertStmt  *sql.Stmt
-	updateStmt  *sql.Stmt
-	deleteStmt  *sql.Stmt
-	countStmt   *sql.Stmt
+	db         *sql.DB
+	insertStmt *sql.Stmt
+	updateStmt *sql.Stmt
+	deleteStmt *sql.Stmt
+	countStmt  *sql.Stmt
 }
 
 func New() *ZormORM {
@@ -24,7 +25,7 @@ func New() *ZormORM {
 
 func (zo *ZormORM) Init(dsn string) error {
 	var err error
-	zo.db, err = sql.Open("sqlite3", dsn)
+	zo.db, err = sql.Open(sqldriver.Name(), dsn)
 	if err != nil {
 		return err
 	}
@@ -32,17 +33,18 @@ func (zo *ZormORM) Init(dsn string) error {
 	return nil
 }
 
-// prepareStatements 预编译常用语句，在CreateTable之后调用
+// prepareStatements 预编译常用语句，在CreateTable之后调用；已预编译的语句直接复用。
+// 语句在并发使用之前准备好，之后只读，各方法直接使用
 func (zo *ZormORM) prepareStatements() error {
 	var err error
 	if zo.insertStmt == nil {
-		zo.insertStmt, err = zo.db.Prepare(`INSERT INTO users (name, email, age) VALUES (?, ?, ?)`)
+		zo.insertStmt, err = zo.db.Prepare(`INSERT INTO users (name, email, age, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`)
 		if err != nil {
 			return err
 		}
 	}
 	if zo.updateStmt == nil {
-		zo.updateStmt, err = zo.db.Prepare(`UPDATE users SET name = ?, email = ?, age = ? WHERE id = ?`)
+		zo.updateStmt, err = zo.db.Prepare(`UPDATE users SET name = ?, email = ?, age = ?, created_at = ?, updated_at = ? WHERE id = ?`)
 		if err != nil {
 			return err
 		}
@@ -85,26 +87,25 @@ func (zo *ZormORM) CreateTable() error {
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			name VARCHAR(100) NOT NULL,
 			email VARCHAR(100) NOT NULL,
-			age INTEGER NOT NULL
+			age INTEGER NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
 		)
 	`)
 	if err != nil {
 		return err
 	}
 
-	// 创建 posts 表
-	_, err = zo.db.Exec(`
-		CREATE TABLE IF NOT EXISTS posts (
-			id INTEGER PRIMARY KEY AUTOINCREMENT,
-			user_id INTEGER NOT NULL,
-			title VARCHAR(200) NOT NULL,
-			body TEXT NOT NULL
-		)
-	`)
+	_, err = zo.db.Exec(`CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at)`)
 	if err != nil {
 		return err
 	}
-	
+
+	// 创建 posts 表
+	if err := zo.CreatePostTable(); err != nil {
+		return err
+	}
+
 	// 表创建后预编译语句
 	return zo.prepareStatements()
 }
@@ -114,18 +115,12 @@ func (zo *ZormORM) DropTable() error {
 	if err != nil {
 		return err
 	}
-	_, err = zo.db.Exec("DROP TABLE IF EXISTS posts")
-	return err
+	return zo.DropPostTable()
 }
 
 func (zo *ZormORM) Insert(user *models.User) error {
 	// 使用预编译语句，提升性能
-	if zo.insertStmt == nil {
-		if err := zo.prepareStatements(); err != nil {
-			return err
-		}
-	}
-	result, err := zo.insertStmt.Exec(user.Name, user.Email, user.Age)
+	result, err := zo.insertStmt.Exec(user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
 	if err != nil {
 		return err
 	}
@@ -143,13 +138,13 @@ func (zo *ZormORM) InsertBatch(users []*models.User) error {
 	}
 
 	// 使用多行INSERT语句，一次性插入所有记录，性能最优
-	query := `INSERT INTO users (name, email, age) VALUES `
-	args := make([]interface{}, 0, len(users)*3)
+	query := `INSERT INTO users (name, email, age, created_at, updated_at) VALUES `
+	args := make([]interface{}, 0, len(users)*5)
 	placeholders := make([]string, 0, len(users))
-	
+
 	for _, user := range users {
-		placeholders = append(placeholders, "(?, ?, ?)")
-		args = append(args, user.Name, user.Email, user.Age)
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
+		args = append(args, user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC())
 	}
 	query += strings.Join(placeholders, ", ")
 
@@ -159,13 +154,14 @@ func (zo *ZormORM) InsertBatch(users []*models.User) error {
 		return err
 	}
 
-	// 获取第一个插入的ID，然后依次递增（SQLite的last_insert_rowid返回第一个ID）
-	firstID, err := result.LastInsertId()
+	// 多行插入时 last_insert_rowid 为最后一行的 ID，单语句内自增 ID 连续
+	lastID, err := result.LastInsertId()
 	if err != nil {
 		return err
 	}
 
-	// 为所有用户设置ID（SQLite批量插入时，ID是连续的）
+	// 为所有用户设置ID
+	firstID := lastID - int64(len(users)) + 1
 	for i := range users {
 		users[i].ID = firstID + int64(i)
 	}
@@ -176,8 +172,8 @@ func (zo *ZormORM) InsertBatch(users []*models.User) error {
 func (zo *ZormORM) GetByID(id int64) (*models.User, error) {
 	// 使用原生SQL替代zorm抽象，提升性能
 	user := &models.User{}
-	err := zo.db.QueryRow("SELECT id, name, email, age FROM users WHERE id = ?", id).
-		Scan(&user.ID, &user.Name, &user.Email, &user.Age)
+	err := zo.db.QueryRow("SELECT id, name, email, age, created_at, updated_at FROM users WHERE id = ?", id).
+		Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("user not found")
 	}
@@ -200,7 +196,7 @@ func (zo *ZormORM) GetByIDs(ids []int64) ([]*models.User, error) {
 		placeholders[i] = "?"
 		args[i] = id
 	}
-	query := "SELECT id, name, email, age FROM users WHERE id IN (" + strings.Join(placeholders, ", ") + ")"
+	query := "SELECT id, name, email, age, created_at, updated_at FROM users WHERE id IN (" + strings.Join(placeholders, ", ") + ")"
 
 	rows, err := zo.db.Query(query, args...)
 	if err != nil {
@@ -211,7 +207,7 @@ func (zo *ZormORM) GetByIDs(ids []int64) ([]*models.User, error) {
 	users := make([]*models.User, 0, len(ids))
 	for rows.Next() {
 		var user models.User
-		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
 			return nil, err
 		}
 		users = append(users, &user)
@@ -221,33 +217,18 @@ func (zo *ZormORM) GetByIDs(ids []int64) ([]*models.User, error) {
 
 func (zo *ZormORM) Update(user *models.User) error {
 	// 使用预编译语句，提升性能
-	if zo.updateStmt == nil {
-		if err := zo.prepareStatements(); err != nil {
-			return err
-		}
-	}
-	_, err := zo.updateStmt.Exec(user.Name, user.Email, user.Age, user.ID)
+	_, err := zo.updateStmt.Exec(user.Name, user.Email, user.Age, user.CreatedAt.UTC(), user.UpdatedAt.UTC(), user.ID)
 	return err
 }
 
 func (zo *ZormORM) Delete(id int64) error {
 	// 使用预编译语句，提升性能
-	if zo.deleteStmt == nil {
-		if err := zo.prepareStatements(); err != nil {
-			return err
-		}
-	}
 	_, err := zo.deleteStmt.Exec(id)
 	return err
 }
 
 func (zo *ZormORM) Count() (int64, error) {
 	// 使用预编译语句，提升性能
-	if zo.countStmt == nil {
-		if err := zo.prepareStatements(); err != nil {
-			return 0, err
-		}
-	}
 	var count int64
 	err := zo.countStmt.QueryRow().Scan(&count)
 	return count, err
@@ -255,7 +236,7 @@ func (zo *ZormORM) Count() (int64, error) {
 
 func (zo *ZormORM) GetAll(limit, offset int) ([]*models.User, error) {
 	// 使用原生SQL替代zorm抽象，提升性能
-	rows, err := zo.db.Query("SELECT id, name, email, age FROM users LIMIT ? OFFSET ?", limit, offset)
+	rows, err := zo.db.Query("SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?", limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -264,7 +245,7 @@ func (zo *ZormORM) GetAll(limit, offset int) ([]*models.User, error) {
 	var users []*models.User
 	for rows.Next() {
 		var user models.User
-		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age); err != nil {
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
 			return nil, err
 		}
 		users = append(users, &user)
@@ -272,6 +253,61 @@ func (zo *ZormORM) GetAll(limit, offset int) ([]*models.User, error) {
 	return users, rows.Err()
 }
 
+func (zo *ZormORM) GetByCreatedRange(from, to time.Time) ([]*models.User, error) {
+	rows, err := zo.db.Query("SELECT id, name, email, age, created_at, updated_at FROM users WHERE created_at >= ? AND created_at < ? ORDER BY created_at", from.UTC(), to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (zo *ZormORM) RawQuery(query string, args ...interface{}) ([]*models.User, error) {
+	rows, err := zo.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (zo *ZormORM) Iterate(fn func(*models.User) error) error {
+	rows, err := zo.db.Query("SELECT id, name, email, age, created_at, updated_at FROM users")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return err
+		}
+		if err := fn(&user); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 // GetDSN 生成测试用的 DSN
 func GetDSN() string {
 	// 使用与GORM相同的DSN格式，启用缓存和内存模式